@@ -28,11 +28,16 @@ API.prototype.blockDump = function (count) {
 	return this.request("post", "/v1/user/blockdump", params);
 };
 
-API.prototype.getAccountState = function (address) {
-	var params = { "address": address };
+API.prototype.getAccountState = function (address, blockHash, height) {
+	var params = { "address": address, "block_hash": blockHash, "height": height };
 	return this.request("post", "/v1/user/accountstate", params);
 };
 
+API.prototype.getContractStorage = function (address, key, blockHash, height) {
+	var params = { "address": address, "key": key, "block_hash": blockHash, "height": height };
+	return this.request("post", "/v1/user/getContractStorage", params);
+};
+
 API.prototype.sendTransaction = function (from, to, value, nonce, gasPrice, gasLimit, contract, candidate, delegate) {
 	var params = {"from": from,
         "to": to,
@@ -47,14 +52,16 @@ API.prototype.sendTransaction = function (from, to, value, nonce, gasPrice, gasL
 	return this.request("post", "/v1/user/transaction", params);
 };
 
-API.prototype.call = function (from, to, value, nonce, gasPrice, gasLimit, contract) {
+API.prototype.call = function (from, to, value, nonce, gasPrice, gasLimit, contract, height, blockHash) {
     var params = {"from": from,
         "to": to,
         "value": utils.toString(value),
         "nonce": nonce,
         "gasPrice": utils.toString(gasPrice),
         "gasLimit": utils.toString(gasLimit),
-        "contract": contract
+        "contract": contract,
+        "height": height,
+        "block_hash": blockHash
     };
 	return this.request("post", "/v1/user/call", params);
 };
@@ -69,6 +76,11 @@ API.prototype.getBlockByHash = function (hash) {
 	return this.request("post", "/v1/user/getBlockByHash", params);
 };
 
+API.prototype.getBlockByHeight = function (height) {
+	var params = { "height": height };
+	return this.request("post", "/v1/user/getBlockByHeight", params);
+};
+
 API.prototype.getTransactionReceipt = function (hash) {
 	var params = { "hash": hash };
 	return this.request("post", "/v1/user/getTransactionReceipt", params);
@@ -83,6 +95,25 @@ API.prototype.gasPrice = function () {
     return this.request("get", "/v1/user/getGasPrice");
 };
 
+API.prototype.getTxPoolStatus = function () {
+	return this.request("get", "/v1/user/txpoolstatus");
+};
+
+API.prototype.getPendingTransactions = function (address) {
+	var params = { "address": address };
+	return this.request("post", "/v1/user/pendingTransactions", params);
+};
+
+API.prototype.getPooledTransaction = function (hash) {
+	var params = { "hash": hash };
+	return this.request("post", "/v1/user/pooledTransaction", params);
+};
+
+API.prototype.getNextNonce = function (address) {
+	var params = { "address": address };
+	return this.request("post", "/v1/user/nextNonce", params);
+};
+
 API.prototype.estimateGas = function (from, to, value, nonce, gasPrice, gasLimit, contract, candidate, delegate) {
     var params = {"from": from,
         "to": to,
@@ -102,6 +133,21 @@ API.prototype.getEventsByHash = function (hash) {
     return this.request("post", "/v1/user/getEventsByHash", params);
 };
 
+API.prototype.getAccountProof = function (address, blockHash, height) {
+    var params = { "address": address, "block_hash": blockHash, "height": height };
+    return this.request("post", "/v1/user/getAccountProof", params);
+};
+
+API.prototype.getStorageProof = function (address, key, blockHash, height) {
+    var params = { "address": address, "key": key, "block_hash": blockHash, "height": height };
+    return this.request("post", "/v1/user/getStorageProof", params);
+};
+
+API.prototype.getTransactionProof = function (hash) {
+    var params = { "hash": hash };
+    return this.request("post", "/v1/user/getTransactionProof", params);
+};
+
 API.prototype.request = function (method, api, params) {
 	return this._request.request(method, api, params);
 };
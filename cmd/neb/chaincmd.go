@@ -19,7 +19,9 @@
 package main
 
 import (
+	"bufio"
 	"fmt"
+	"os"
 	"strconv"
 
 	"bytes"
@@ -67,6 +69,59 @@ Dump the genesis config info.`,
 		Description: `
 Use "./neb dump 10" to dump 10 blocks before tail block.`,
 	}
+
+	exportCommand = cli.Command{
+		Action:    MergeFlags(exportBlocks),
+		Name:      "export",
+		Usage:     "Export the canonical blocks to file",
+		ArgsUsage: "<filename> [<from> [<to>]]",
+		Category:  "BLOCKCHAIN COMMANDS",
+		Description: `
+Use "./neb export chain.dat 1 100" to export the blocks from height 1 to 100.
+The blocks from genesis to tail are exported if the heights are omitted.`,
+	}
+
+	importCommand = cli.Command{
+		Action:    MergeFlags(importBlocks),
+		Name:      "import",
+		Usage:     "Import the blocks from an exported file",
+		ArgsUsage: "<filename>",
+		Category:  "BLOCKCHAIN COMMANDS",
+		Description: `
+Use "./neb import chain.dat" to verify and import the blocks in chain.dat,
+each block is executed and verified by consensus, then set as the tail.`,
+	}
+
+	snapshotCommand = cli.Command{
+		Name:     "snapshot",
+		Usage:    "the state snapshot command",
+		Category: "BLOCKCHAIN COMMANDS",
+		Description: `
+The snapshot command for state snapshot export and import.`,
+		Subcommands: []cli.Command{
+			{
+				Name:      "export",
+				Usage:     "export the state snapshot of a block",
+				ArgsUsage: "<filename> [<height>]",
+				Action:    MergeFlags(exportSnapshot),
+				Description: `
+    neb snapshot export snapshot.dat 1000
+
+Export the states of the canonical block at height 1000, the tail block if omitted.`,
+			},
+			{
+				Name:      "import",
+				Usage:     "bootstrap the chain from a state snapshot",
+				ArgsUsage: "<filename>",
+				Action:    MergeFlags(importSnapshot),
+				Description: `
+    neb snapshot import snapshot.dat
+
+Import the states in snapshot.dat and set its block as the tail,
+the chain must contain the genesis block only.`,
+			},
+		},
+	}
 )
 
 func initGenesis(ctx *cli.Context) error {
@@ -131,3 +186,148 @@ func dumpblock(ctx *cli.Context) error {
 	fmt.Printf("blockchain dump: %s\n", neb.BlockChain().Dump(count))
 	return nil
 }
+
+func exportBlocks(ctx *cli.Context) error {
+	if len(ctx.Args()) < 1 {
+		FatalF("export needs a filename")
+	}
+	var heights []uint64
+	for _, arg := range ctx.Args()[1:] {
+		height, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			FatalF("invalid block height %s: %v", arg, err)
+		}
+		heights = append(heights, height)
+	}
+	var from, to uint64
+	if len(heights) > 0 {
+		from = heights[0]
+	}
+	if len(heights) > 1 {
+		to = heights[1]
+	}
+
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+	if err := neb.Setup(); err != nil {
+		return err
+	}
+
+	file, err := os.Create(ctx.Args().First())
+	if err != nil {
+		FatalF("export blocks failed: %v", err)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	count, err := neb.BlockChain().ExportBlocks(writer, from, to)
+	if err != nil {
+		FatalF("export blocks failed: %v", err)
+	}
+	if err := writer.Flush(); err != nil {
+		FatalF("export blocks failed: %v", err)
+	}
+	fmt.Printf("export %d blocks success.\n", count)
+	return nil
+}
+
+func importBlocks(ctx *cli.Context) error {
+	if len(ctx.Args()) < 1 {
+		FatalF("import needs a filename")
+	}
+
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+	if err := neb.Setup(); err != nil {
+		return err
+	}
+
+	file, err := os.Open(ctx.Args().First())
+	if err != nil {
+		FatalF("import blocks failed: %v", err)
+	}
+	defer file.Close()
+
+	count, err := neb.BlockChain().ImportBlocks(bufio.NewReader(file))
+	if err != nil {
+		FatalF("import blocks failed after %d blocks: %v", count, err)
+	}
+	fmt.Printf("import %d blocks success, tail: %s\n", count, neb.BlockChain().TailBlock())
+	return nil
+}
+
+func exportSnapshot(ctx *cli.Context) error {
+	if len(ctx.Args()) < 1 {
+		FatalF("snapshot export needs a filename")
+	}
+	var height uint64
+	if len(ctx.Args()) > 1 {
+		var err error
+		if height, err = strconv.ParseUint(ctx.Args().Get(1), 10, 64); err != nil {
+			FatalF("invalid block height %s: %v", ctx.Args().Get(1), err)
+		}
+	}
+
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+	if err := neb.Setup(); err != nil {
+		return err
+	}
+
+	block := neb.BlockChain().TailBlock()
+	if height > 0 {
+		if block = neb.BlockChain().GetBlockByHeight(height); block == nil {
+			FatalF("block at height %d not found", height)
+		}
+	}
+
+	file, err := os.Create(ctx.Args().First())
+	if err != nil {
+		FatalF("export snapshot failed: %v", err)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	count, err := neb.BlockChain().ExportSnapshot(writer, block)
+	if err != nil {
+		FatalF("export snapshot failed: %v", err)
+	}
+	if err := writer.Flush(); err != nil {
+		FatalF("export snapshot failed: %v", err)
+	}
+	fmt.Printf("export snapshot of %s with %d nodes success.\n", block, count)
+	return nil
+}
+
+func importSnapshot(ctx *cli.Context) error {
+	if len(ctx.Args()) < 1 {
+		FatalF("snapshot import needs a filename")
+	}
+
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+	if err := neb.Setup(); err != nil {
+		return err
+	}
+
+	file, err := os.Open(ctx.Args().First())
+	if err != nil {
+		FatalF("import snapshot failed: %v", err)
+	}
+	defer file.Close()
+
+	block, err := neb.BlockChain().ImportSnapshot(bufio.NewReader(file))
+	if err != nil {
+		FatalF("import snapshot failed: %v", err)
+	}
+	fmt.Printf("import snapshot success, tail: %s\n", block)
+	return nil
+}
@@ -80,6 +80,9 @@ func main() {
 		licenseCommand,
 		configCommand,
 		blockDumpCommand,
+		exportCommand,
+		importCommand,
+		snapshotCommand,
 		serializeCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
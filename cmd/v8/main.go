@@ -39,11 +39,11 @@ func main() {
 	mem, _ := storage.NewMemoryStorage()
 	context, _ := state.NewAccountState(nil, mem)
 	owner := context.GetOrCreateUserAccount([]byte("account1"))
-	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
 
 	ctx := nvm.NewContext(nil, nil, owner, contract, context)
 	engine := nvm.NewV8Engine(ctx)
-	err := engine.RunScriptSource(string(data), 0)
+	_, err := engine.RunScriptSource(string(data), 0)
 
 	log.Errorf("Err is %s", err)
 
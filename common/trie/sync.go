@@ -18,14 +18,143 @@
 
 package trie
 
-// SyncTrie data from other servers
-// Sync whole trie to build snapshot
+import (
+	"bytes"
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie/pb"
+)
+
+// Errors in sync
+var (
+	ErrInvalidNodeHash = errors.New("trie node does not match its hash")
+)
+
+// SyncTrie check the whole trie of rootHash is in storage and every node matches its hash,
+// then reset the trie to rootHash. Called after the nodes are synced from a snapshot or other servers.
 func (t *Trie) SyncTrie(rootHash []byte) error {
+	if len(rootHash) > 0 {
+		if err := t.syncNode(rootHash); err != nil {
+			return err
+		}
+	}
+	t.rootHash = rootHash
 	return nil
 }
 
-// SyncPath from rootHash to key node from other servers
-// Useful for verification quickly
+// SyncPath check the path from rootHash to key node is in storage and every node matches its hash,
+// then reset the trie to rootHash. Useful for verification quickly
 func (t *Trie) SyncPath(rootHash []byte, key []byte) error {
-	return nil
+	curRoute := keyToRoute(key)
+	curRootHash := rootHash
+	for len(curRoute) > 0 {
+		if len(curRootHash) == 0 {
+			return ErrNotFound
+		}
+		rootNode, err := t.fetchSyncedNode(curRootHash)
+		if err != nil {
+			return err
+		}
+		flag, err := rootNode.Type()
+		if err != nil {
+			return err
+		}
+		switch flag {
+		case branch:
+			curRootHash = rootNode.Val[curRoute[0]]
+			curRoute = curRoute[1:]
+		case ext:
+			path := rootNode.Val[1]
+			matchLen := prefixLen(path, curRoute)
+			if matchLen != len(path) {
+				return ErrNotFound
+			}
+			curRootHash = rootNode.Val[2]
+			curRoute = curRoute[matchLen:]
+		case leaf:
+			path := rootNode.Val[1]
+			if prefixLen(path, curRoute) != len(path) {
+				return ErrNotFound
+			}
+			t.rootHash = rootHash
+			return nil
+		default:
+			return errors.New("unknown node type")
+		}
+	}
+	return ErrNotFound
+}
+
+// NodeChildren decode the node synced from other servers, return the hashes of its children,
+// and the value if it is a leaf node.
+func NodeChildren(ir []byte) ([][]byte, []byte, error) {
+	pb := new(triepb.Node)
+	if err := proto.Unmarshal(ir, pb); err != nil {
+		return nil, nil, err
+	}
+	n := new(node)
+	if err := n.FromProto(pb); err != nil {
+		return nil, nil, err
+	}
+	flag, err := n.Type()
+	if err != nil {
+		return nil, nil, err
+	}
+	switch flag {
+	case branch:
+		var children [][]byte
+		for i := 0; i < 16; i++ {
+			if len(n.Val[i]) > 0 {
+				children = append(children, n.Val[i])
+			}
+		}
+		return children, nil, nil
+	case ext:
+		return [][]byte{n.Val[2]}, nil, nil
+	case leaf:
+		return nil, n.Val[2], nil
+	default:
+		return nil, nil, errors.New("unknown node type")
+	}
+}
+
+func (t *Trie) syncNode(hash []byte) error {
+	n, err := t.fetchSyncedNode(hash)
+	if err != nil {
+		return err
+	}
+	flag, err := n.Type()
+	if err != nil {
+		return err
+	}
+	switch flag {
+	case branch:
+		for i := 0; i < 16; i++ {
+			if len(n.Val[i]) == 0 {
+				continue
+			}
+			if err := t.syncNode(n.Val[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	case ext:
+		return t.syncNode(n.Val[2])
+	case leaf:
+		return nil
+	default:
+		return errors.New("unknown node type")
+	}
+}
+
+func (t *Trie) fetchSyncedNode(hash []byte) (*node, error) {
+	n, err := t.fetchNode(hash)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(n.Hash, hash) {
+		return nil, ErrInvalidNodeHash
+	}
+	return n, nil
 }
@@ -0,0 +1,79 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrie_Sync(t *testing.T) {
+	src, _ := storage.NewMemoryStorage()
+	tr, _ := NewTrie(nil, src)
+	names := []string{"123450", "123350", "122450", "223350", "133350"}
+	for _, v := range names {
+		key, _ := byteutils.FromHex(v)
+		tr.Put(key, []byte(v))
+	}
+
+	dst, _ := storage.NewMemoryStorage()
+	synced, _ := NewTrie(nil, dst)
+	assert.Equal(t, synced.SyncTrie(tr.RootHash()), ErrNotFound)
+	assert.Nil(t, synced.SyncTrie(nil))
+
+	// copy the nodes one by one, the root is copied last.
+	var hashes [][]byte
+	assert.Nil(t, tr.Walk(func(hash []byte, leafVal []byte) (bool, error) {
+		hashes = append(hashes, hash)
+		return true, nil
+	}))
+	key, _ := byteutils.FromHex(names[0])
+	for i := len(hashes) - 1; i >= 0; i-- {
+		value, err := src.Get(hashes[i])
+		assert.Nil(t, err)
+		assert.Nil(t, dst.Put(hashes[i], value))
+	}
+	assert.Nil(t, synced.SyncPath(tr.RootHash(), key))
+	assert.Nil(t, synced.SyncTrie(tr.RootHash()))
+	leaves := 0
+	assert.Nil(t, synced.Walk(func(hash []byte, leafVal []byte) (bool, error) {
+		if leafVal != nil {
+			leaves++
+		}
+		return true, nil
+	}))
+	assert.Equal(t, len(names), leaves)
+	missing, _ := byteutils.FromHex("999999")
+	assert.Equal(t, synced.SyncPath(tr.RootHash(), missing), ErrNotFound)
+
+	// children of the nodes cover the whole trie.
+	rootIR, err := src.Get(tr.RootHash())
+	assert.Nil(t, err)
+	children, leafVal, err := NodeChildren(rootIR)
+	assert.Nil(t, err)
+	assert.Nil(t, leafVal)
+	assert.True(t, len(children) > 0)
+
+	// a node not matching its hash is rejected.
+	assert.Nil(t, dst.Put(hashes[len(hashes)-1], []byte{}))
+	assert.Equal(t, synced.SyncTrie(tr.RootHash()), ErrInvalidNodeHash)
+}
@@ -93,6 +93,9 @@ func (n *node) Type() (ty, error) {
 type Trie struct {
 	rootHash []byte
 	storage  storage.Storage
+
+	// batch collects the nodes committed by an update, written to storage at once.
+	batch storage.Batch
 }
 
 // CreateNode in trie
@@ -132,12 +135,15 @@ func (t *Trie) commitNode(n *node) error {
 		return err
 	}
 	n.Hash = hash.Sha3256(n.Bytes)
+	if t.batch != nil {
+		return t.batch.Put(n.Hash, n.Bytes)
+	}
 	return t.storage.Put(n.Hash, n.Bytes)
 }
 
 // NewTrie if rootHash is nil, create a new Trie, otherwise, build an existed trie
 func NewTrie(rootHash []byte, storage storage.Storage) (*Trie, error) {
-	t := &Trie{rootHash, storage}
+	t := &Trie{rootHash: rootHash, storage: storage}
 	if t.rootHash == nil {
 		return t, nil
 	} else if _, err := t.storage.Get(rootHash); err != nil {
@@ -204,10 +210,16 @@ func (t *Trie) get(rootHash []byte, route []byte) ([]byte, error) {
 
 // Put the key-value pair in trie
 func (t *Trie) Put(key []byte, val []byte) ([]byte, error) {
+	t.batch = t.storage.NewBatch()
+	defer func() { t.batch = nil }()
+
 	newHash, err := t.update(t.rootHash, keyToRoute(key), val)
 	if err != nil {
 		return nil, err
 	}
+	if err := t.batch.Write(); err != nil {
+		return nil, err
+	}
 	t.rootHash = newHash
 	return newHash, nil
 }
@@ -382,10 +394,16 @@ func (t *Trie) updateWhenMeetLeaf(rootNode *node, route []byte, val []byte) ([]b
 
 // Del the node's value in trie
 func (t *Trie) Del(key []byte) ([]byte, error) {
+	t.batch = t.storage.NewBatch()
+	defer func() { t.batch = nil }()
+
 	newHash, err := t.del(t.rootHash, keyToRoute(key))
 	if err != nil {
 		return nil, err
 	}
+	if err := t.batch.Write(); err != nil {
+		return nil, err
+	}
 	t.rootHash = newHash
 	return newHash, nil
 }
@@ -452,7 +470,7 @@ func (t *Trie) del(root []byte, route []byte) ([]byte, error) {
 
 // Clone the trie to create a new trie sharing the same storage
 func (t *Trie) Clone() (*Trie, error) {
-	return &Trie{t.rootHash, t.storage}, nil
+	return &Trie{rootHash: t.rootHash, storage: t.storage}, nil
 }
 
 // prefixLen returns the length of the common prefix between a and b.
@@ -0,0 +1,73 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	"errors"
+)
+
+// WalkFunc is called for each node visited by Walk with the node hash,
+// and the value if the node is a leaf. The sub-trie under the node is skipped if it returns false.
+type WalkFunc func(hash []byte, leafVal []byte) (bool, error)
+
+// Walk visit all nodes reachable from the trie root in depth-first order,
+// it returns ErrNotFound if a node is missing in storage.
+func (t *Trie) Walk(fn WalkFunc) error {
+	if t.rootHash == nil || len(t.rootHash) == 0 {
+		return nil
+	}
+	return t.walk(t.rootHash, fn)
+}
+
+func (t *Trie) walk(hash []byte, fn WalkFunc) error {
+	n, err := t.fetchNode(hash)
+	if err != nil {
+		return err
+	}
+	flag, err := n.Type()
+	if err != nil {
+		return err
+	}
+	var leafVal []byte
+	if flag == leaf {
+		leafVal = n.Val[2]
+	}
+	next, err := fn(hash, leafVal)
+	if err != nil || !next {
+		return err
+	}
+	switch flag {
+	case branch:
+		for i := 0; i < 16; i++ {
+			if len(n.Val[i]) == 0 {
+				continue
+			}
+			if err := t.walk(n.Val[i], fn); err != nil {
+				return err
+			}
+		}
+		return nil
+	case ext:
+		return t.walk(n.Val[2], fn)
+	case leaf:
+		return nil
+	default:
+		return errors.New("unknown node type")
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrie_Walk(t *testing.T) {
+	storage, _ := storage.NewMemoryStorage()
+	tr, err := NewTrie(nil, storage)
+	assert.Nil(t, err)
+	assert.Nil(t, tr.Walk(func(hash []byte, leafVal []byte) (bool, error) {
+		t.Error("empty trie should not be walked")
+		return true, nil
+	}))
+
+	names := []string{"123450", "123350", "122450", "223350", "133350"}
+	for _, v := range names {
+		key, err := byteutils.FromHex(v)
+		assert.Nil(t, err)
+		tr.Put(key, []byte(v))
+	}
+
+	nodes := 0
+	var leaves []string
+	assert.Nil(t, tr.Walk(func(hash []byte, leafVal []byte) (bool, error) {
+		nodes++
+		if leafVal != nil {
+			leaves = append(leaves, string(leafVal))
+		}
+		return true, nil
+	}))
+	sort.Strings(names)
+	sort.Strings(leaves)
+	assert.Equal(t, names, leaves)
+	assert.True(t, nodes > len(names))
+
+	// skip the children of root.
+	nodes = 0
+	assert.Nil(t, tr.Walk(func(hash []byte, leafVal []byte) (bool, error) {
+		nodes++
+		return false, nil
+	}))
+	assert.Equal(t, 1, nodes)
+
+	// nodes missing in storage fail the walk.
+	assert.Nil(t, storage.Del(tr.RootHash()))
+	assert.Equal(t, ErrNotFound, tr.Walk(func(hash []byte, leafVal []byte) (bool, error) {
+		return true, nil
+	}))
+}
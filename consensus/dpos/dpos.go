@@ -137,7 +137,8 @@ func (p *Dpos) forkChoice() {
 	newTailBlock := tailBlock
 
 	for _, v := range detachedTailBlocks {
-		if less(newTailBlock, v) {
+		// the forks below the last irreversible block are never chosen.
+		if less(newTailBlock, v) && bc.DescendsFromLIB(v) {
 			newTailBlock = v
 		}
 	}
@@ -24,12 +24,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/crypto/sha3"
 
@@ -181,24 +183,98 @@ func (block *Block) SerializeTxByHash(hash byteutils.Hash) (proto.Message, error
 	return tx.ToProto()
 }
 
+// GetContractSource return the current source of the contract from storage by its code hash.
+func (block *Block) GetContractSource(contract state.Account) (*nvm.ContractSource, error) {
+	if contract.Destroyed() {
+		return nil, ErrContractDestroyed
+	}
+	if len(contract.CodeHash()) == 0 {
+		return block.getBirthContractSource(contract)
+	}
+	owner, err := block.contractOwner(contract)
+	if err != nil {
+		return nil, err
+	}
+	// the code is not loaded if its prepared script is cached.
+	if traceable, lineOffset, ok := nvm.GetPreparedScript(contract.CodeHash()); ok {
+		return &nvm.ContractSource{
+			Owner:               owner,
+			CodeHash:            contract.CodeHash(),
+			Traceable:           traceable,
+			TraceableLineOffset: lineOffset,
+		}, nil
+	}
+	code, err := loadContractCode(block.storage, contract.CodeHash())
+	if err != nil {
+		return nil, err
+	}
+	return &nvm.ContractSource{
+		Owner:      owner,
+		CodeHash:   contract.CodeHash(),
+		Source:     code.Source,
+		SourceType: code.SourceType,
+	}, nil
+}
+
+// getBirthContractSource return the source in the deploy tx of contracts deployed before codes are stored by hash.
+func (block *Block) getBirthContractSource(contract state.Account) (*nvm.ContractSource, error) {
+	birthTx, err := block.GetTransaction(contract.BirthPlace())
+	if err != nil {
+		return nil, err
+	}
+	deploy, err := LoadDeployPayload(birthTx.data.Payload)
+	if err != nil {
+		return nil, err
+	}
+	source := &nvm.ContractSource{
+		Owner:      birthTx.from.Bytes(),
+		CodeHash:   CodeHash(deploy.SourceType, deploy.Source),
+		Source:     deploy.Source,
+		SourceType: deploy.SourceType,
+	}
+	source.Traceable, source.TraceableLineOffset, _ = nvm.GetPreparedScript(source.CodeHash)
+	return source, nil
+}
+
+// contractOwner return the owner of the contract, from its deploy tx if it has no owner recorded.
+func (block *Block) contractOwner(contract state.Account) (byteutils.Hash, error) {
+	if len(contract.Owner()) > 0 {
+		return contract.Owner(), nil
+	}
+	birthTx, err := block.GetTransaction(contract.BirthPlace())
+	if err != nil {
+		return nil, err
+	}
+	return birthTx.from.Bytes(), nil
+}
+
 // NewBlock return new block.
 func NewBlock(chainID uint32, coinbase *Address, parent *Block) (*Block, error) {
-	accState, err := parent.accState.Clone()
+	// the changes of block are buffered in an overlay of parent's storage.
+	overlay := storage.NewOverlayStorage(parent.storage)
+	accState, err := state.NewAccountState(parent.accState.RootHash(), overlay)
+	if err != nil {
+		return nil, err
+	}
+	txsTrie, err := trie.NewBatchTrie(parent.txsTrie.RootHash(), overlay)
 	if err != nil {
 		return nil, err
 	}
-	txsTrie, err := parent.txsTrie.Clone()
+	eventsTrie, err := trie.NewBatchTrie(parent.eventsTrie.RootHash(), overlay)
 	if err != nil {
 		return nil, err
 	}
-	eventsTrie, err := parent.eventsTrie.Clone()
+	dposContext, err := NewDposContext(overlay)
 	if err != nil {
 		return nil, err
 	}
-	dposContext, err := parent.dposContext.Clone()
+	pbDposContext, err := parent.dposContext.ToProto()
 	if err != nil {
 		return nil, err
 	}
+	if err := dposContext.FromProto(pbDposContext); err != nil {
+		return nil, err
+	}
 	block := &Block{
 		header: &BlockHeader{
 			parentHash:  parent.Hash(),
@@ -217,12 +293,71 @@ func NewBlock(chainID uint32, coinbase *Address, parent *Block) (*Block, error)
 		txPool:       parent.txPool,
 		height:       parent.height + 1,
 		sealed:       false,
-		storage:      parent.storage,
+		storage:      overlay,
 		eventEmitter: parent.eventEmitter,
 	}
 	return block, nil
 }
 
+// sandbox return a copy of block whose tries are backed by an in-memory overlay of its storage,
+// used to execute transactions without side effect.
+func (block *Block) sandbox() (*Block, error) {
+	overlay := storage.NewOverlayStorage(block.storage)
+	accState, err := state.NewAccountState(block.accState.RootHash(), overlay)
+	if err != nil {
+		return nil, err
+	}
+	txsTrie, err := trie.NewBatchTrie(block.txsTrie.RootHash(), overlay)
+	if err != nil {
+		return nil, err
+	}
+	eventsTrie, err := trie.NewBatchTrie(block.eventsTrie.RootHash(), overlay)
+	if err != nil {
+		return nil, err
+	}
+	dposContext, err := NewDposContext(overlay)
+	if err != nil {
+		return nil, err
+	}
+	pbDposContext, err := block.dposContext.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	if err := dposContext.FromProto(pbDposContext); err != nil {
+		return nil, err
+	}
+	return &Block{
+		header:       block.header,
+		transactions: block.transactions,
+		sealed:       block.sealed,
+		height:       block.height,
+		parenetBlock: block.parenetBlock,
+		accState:     accState,
+		txsTrie:      txsTrie,
+		eventsTrie:   eventsTrie,
+		dposContext:  dposContext,
+		txPool:       block.txPool,
+		miner:        block.miner,
+		storage:      overlay,
+		eventEmitter: block.eventEmitter,
+	}, nil
+}
+
+// flush write the changes of block buffered in its storage into batch.
+func (block *Block) flush(batch storage.Batch) error {
+	if overlay, ok := block.storage.(*storage.OverlayStorage); ok {
+		return overlay.Flush(batch)
+	}
+	return nil
+}
+
+// flushed let block read its storage from base directly, once the flushed batch is written.
+func (block *Block) flushed(base storage.Storage) {
+	if overlay, ok := block.storage.(*storage.OverlayStorage); ok {
+		overlay.Rebase(base)
+	}
+}
+
 // Sign sign transaction,sign algorithm is
 func (block *Block) Sign(signature keystore.Signature) error {
 	sign, err := signature.Sign(block.header.hash)
@@ -361,6 +496,32 @@ func (block *Block) SetMiner(miner *Address) {
 	block.miner = miner
 }
 
+// recoverMiner return the miner of block, recovered from the signature if unknown.
+func (block *Block) recoverMiner() (*Address, error) {
+	if block.miner != nil {
+		return block.miner, nil
+	}
+	signature, err := crypto.NewSignature(keystore.Algorithm(block.Alg()))
+	if err != nil {
+		return nil, err
+	}
+	pub, err := signature.RecoverPublic(block.Hash(), block.Signature())
+	if err != nil {
+		return nil, err
+	}
+	pubdata, err := pub.Encoded()
+	if err != nil {
+		return nil, err
+	}
+	return NewAddressFromPublicKey(pubdata)
+}
+
+// inDynasty return if the addr is a member of the dynasty the block is minted in.
+func (block *Block) inDynasty(addr *Address) bool {
+	_, err := block.dposContext.dynastyTrie.Get(addr.Bytes())
+	return err == nil
+}
+
 // VerifyAddress returns if the addr string is valid
 func (block *Block) VerifyAddress(str string) bool {
 	_, err := AddressParse(str)
@@ -373,45 +534,46 @@ func (block *Block) LinkParentBlock(parentBlock *Block) bool {
 		return false
 	}
 
-	var err error
-	if block.accState, err = parentBlock.accState.Clone(); err != nil {
+	// the changes of block are buffered in the storage of its dynasty context,
+	// and written with the block when it is stored.
+	elapsedSecond := block.Timestamp() - parentBlock.Timestamp()
+	context, err := parentBlock.NextDynastyContext(elapsedSecond)
+	if err != nil {
 		log.WithFields(log.Fields{
 			"func":  "block.LinkParentBlock",
 			"block": parentBlock,
 			"err":   err,
-		}).Error("cannot clone account state.")
+		}).Error("calculate next dynasty context.")
 		return false
 	}
-	if block.txsTrie, err = parentBlock.txsTrie.Clone(); err != nil {
+	block.storage = context.Storage
+	if block.accState, err = state.NewAccountState(parentBlock.accState.RootHash(), block.storage); err != nil {
 		log.WithFields(log.Fields{
 			"func":  "block.LinkParentBlock",
 			"block": parentBlock,
 			"err":   err,
-		}).Error("cannot clone txs state.")
+		}).Error("cannot clone account state.")
 		return false
 	}
-	if block.eventsTrie, err = parentBlock.eventsTrie.Clone(); err != nil {
+	if block.txsTrie, err = trie.NewBatchTrie(parentBlock.txsTrie.RootHash(), block.storage); err != nil {
 		log.WithFields(log.Fields{
 			"func":  "block.LinkParentBlock",
 			"block": parentBlock,
 			"err":   err,
-		}).Error("cannot clone events state.")
+		}).Error("cannot clone txs state.")
 		return false
 	}
-	elapsedSecond := block.Timestamp() - parentBlock.Timestamp()
-	context, err := parentBlock.NextDynastyContext(elapsedSecond)
-	if err != nil {
+	if block.eventsTrie, err = trie.NewBatchTrie(parentBlock.eventsTrie.RootHash(), block.storage); err != nil {
 		log.WithFields(log.Fields{
 			"func":  "block.LinkParentBlock",
 			"block": parentBlock,
 			"err":   err,
-		}).Error("calculate next dynasty context.")
+		}).Error("cannot clone events state.")
 		return false
 	}
 	block.LoadDynastyContext(context)
 	block.txPool = parentBlock.txPool
 	block.parenetBlock = parentBlock
-	block.storage = parentBlock.storage
 	block.height = parentBlock.height + 1
 	block.eventEmitter = parentBlock.eventEmitter
 
@@ -463,9 +625,7 @@ func (block *Block) rollback() {
 }
 
 // ReturnTransactions and giveback them to tx pool
-// TODO(roy): optimize storage.
-// if a block is reverted, we should erase all changes
-// made by this block on storage. use refcount.
+// the changes made by a reverted block on storage are reclaimed by StatePruner.
 func (block *Block) ReturnTransactions() {
 	for _, tx := range block.transactions {
 		block.txPool.Push(tx)
@@ -480,8 +640,11 @@ func (block *Block) CollectTransactions(n int) {
 
 	pool := block.txPool
 	var givebacks []*Transaction
-	for !pool.Empty() && n > 0 {
+	for n > 0 {
 		tx := pool.Pop()
+		if tx == nil {
+			break
+		}
 		block.begin()
 		giveback, err := block.executeTransaction(tx)
 		if giveback {
@@ -602,6 +765,10 @@ func (block *Block) triggerEvent() {
 			topic = TopicDelegate
 		case TxPayloadCandidateType:
 			topic = TopicCandidate
+		case TxPayloadUpgradeType:
+			topic = TopicUpgradeSmartContract
+		case TxPayloadDestructType:
+			topic = TopicDestructSmartContract
 		}
 		data, err := json.Marshal(v)
 		event := &Event{
@@ -775,6 +942,32 @@ func (block *Block) FetchEvents(txHash byteutils.Hash) ([]*Event, error) {
 	return events, nil
 }
 
+// FetchExecutionResultEvent fetch the execution result of the transaction of given txHash.
+func (block *Block) FetchExecutionResultEvent(txHash byteutils.Hash) (*TransactionEvent, error) {
+	events, err := block.FetchEvents(txHash)
+	if err != nil {
+		return nil, err
+	}
+	// the execution result is the last event recorded by the transaction.
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		if event.Topic != TopicExecuteTxSuccess && event.Topic != TopicExecuteTxFailed {
+			continue
+		}
+		txEvent := new(TransactionEvent)
+		if err := json.Unmarshal([]byte(event.Data), txEvent); err != nil {
+			return nil, err
+		}
+		if event.Topic == TopicExecuteTxSuccess {
+			txEvent.Status = TxExecutionSuccess
+		} else {
+			txEvent.Status = TxExecutionFailed
+		}
+		return txEvent, nil
+	}
+	return nil, ErrExecutionResultNotFound
+}
+
 func (block *Block) recordMintCnt() error {
 	key := append(byteutils.FromInt64(block.Timestamp()/DynastyInterval), block.miner.Bytes()...)
 	bytes, err := block.dposContext.mintCntTrie.Get(key)
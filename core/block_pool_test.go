@@ -67,7 +67,9 @@ func TestBlockPool(t *testing.T) {
 	bc.tailBlock.accState.GetOrCreateUserAccount(from.Bytes()).AddBalance(balance)
 	bc.tailBlock.header.stateRoot = bc.tailBlock.accState.RootHash()
 	bc.tailBlock.commit()
-	bc.storeBlockToStorage(bc.tailBlock)
+	batch := bc.storage.NewBatch()
+	bc.storeBlockToStorage(batch, bc.tailBlock)
+	batch.Write()
 
 	validators, err := TraverseDynasty(bc.tailBlock.dposContext.dynastyTrie)
 	assert.Nil(t, err)
@@ -26,10 +26,12 @@ import (
 	pb "github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -258,10 +260,10 @@ func TestBlock_CollectTransactions(t *testing.T) {
 	bc.txPool.Push(tx6)
 
 	assert.Equal(t, len(block.transactions), 0)
-	assert.Equal(t, bc.txPool.cache.Len(), 5)
-	block.CollectTransactions(bc.txPool.cache.Len())
+	assert.Equal(t, len(bc.txPool.all), 4)
+	block.CollectTransactions(len(bc.txPool.all))
 	assert.Equal(t, len(block.transactions), 4)
-	assert.Equal(t, block.txPool.cache.Len(), 0)
+	assert.Equal(t, len(block.txPool.all), 0)
 
 	assert.Equal(t, block.Sealed(), false)
 	balance := block.GetBalance(block.header.coinbase.address)
@@ -325,10 +327,10 @@ func TestBlock_DposCandidates(t *testing.T) {
 	tx.Sign(signature)
 	bc.txPool.Push(tx)
 	assert.Equal(t, len(block.transactions), 0)
-	assert.Equal(t, bc.txPool.cache.Len(), 2)
+	assert.Equal(t, len(bc.txPool.all), 2)
 	block.CollectTransactions(2)
 	assert.Equal(t, len(block.transactions), 2)
-	assert.Equal(t, block.txPool.cache.Len(), 0)
+	assert.Equal(t, len(block.txPool.all), 0)
 	block.SetMiner(coinbase)
 	assert.Equal(t, block.Seal(), nil)
 	block, _ = mockBlockFromNetwork(block)
@@ -351,10 +353,10 @@ func TestBlock_DposCandidates(t *testing.T) {
 	tx.Sign(signature)
 	bc.txPool.Push(tx)
 	assert.Equal(t, len(block.transactions), 0)
-	assert.Equal(t, bc.txPool.cache.Len(), 1)
+	assert.Equal(t, len(bc.txPool.all), 1)
 	block.CollectTransactions(1)
 	assert.Equal(t, len(block.transactions), 1)
-	assert.Equal(t, block.txPool.cache.Len(), 0)
+	assert.Equal(t, len(block.txPool.all), 0)
 	block.SetMiner(coinbase)
 	assert.Equal(t, block.Seal(), nil)
 	block, _ = mockBlockFromNetwork(block)
@@ -381,10 +383,10 @@ func TestBlock_DposCandidates(t *testing.T) {
 	tx.Sign(signature)
 	bc.txPool.Push(tx)
 	assert.Equal(t, len(block.transactions), 0)
-	assert.Equal(t, bc.txPool.cache.Len(), 2)
+	assert.Equal(t, len(bc.txPool.all), 2)
 	block.CollectTransactions(2)
 	assert.Equal(t, len(block.transactions), 2)
-	assert.Equal(t, block.txPool.cache.Len(), 0)
+	assert.Equal(t, len(block.txPool.all), 0)
 	block.SetMiner(coinbase)
 	assert.Equal(t, block.Seal(), nil)
 	block, _ = mockBlockFromNetwork(block)
@@ -419,3 +421,78 @@ func TestBlock_fetchEvents(t *testing.T) {
 		assert.Equal(t, events[idx], event)
 	}
 }
+
+func TestBlock_GetContractSource(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	block, _ := NewBlock(0, bc.genesisBlock.Coinbase(), bc.tailBlock)
+	block.begin()
+
+	code := &ContractCode{SourceType: "js", Source: "source"}
+	codeHash, err := storeContractCode(block.storage, code)
+	assert.Nil(t, err)
+	assert.Equal(t, CodeHash("js", "source"), codeHash)
+	assert.NotEqual(t, CodeHash("j", "ssource"), codeHash)
+
+	// the code is loaded by hash, without the deploy tx.
+	owner := []byte("owner")
+	contract, _ := block.accState.CreateContractAccount([]byte("contract"), []byte("missing deploy tx"), owner, codeHash)
+	source, err := block.GetContractSource(contract)
+	assert.Nil(t, err)
+	assert.Equal(t, owner, []byte(source.Owner))
+	assert.Equal(t, codeHash, source.CodeHash)
+	assert.Equal(t, code.Source, source.Source)
+	assert.Equal(t, code.SourceType, source.SourceType)
+	// the traceable source is prepared by the engine.
+	assert.Equal(t, "", source.Traceable)
+
+	contract, _ = block.accState.CreateContractAccount([]byte("unknown"), []byte("missing deploy tx"), owner, CodeHash("js", "unknown"))
+	_, err = block.GetContractSource(contract)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	forgedHash := CodeHash("js", "forged")
+	assert.Nil(t, block.storage.Put(forgedHash, code.ToBytes()))
+	contract, _ = block.accState.CreateContractAccount([]byte("forged"), []byte("missing deploy tx"), owner, forgedHash)
+	_, err = block.GetContractSource(contract)
+	assert.Equal(t, ErrInvalidContractCode, err)
+}
+
+func TestContractCode_Bytes(t *testing.T) {
+	code := &ContractCode{SourceType: "ts", Source: "source"}
+	decoded := new(ContractCode)
+	assert.Nil(t, decoded.FromBytes(code.ToBytes()))
+	assert.Equal(t, code, decoded)
+	assert.Equal(t, CodeHash(code.SourceType, code.Source), byteutils.Hash(hash.Sha3256(code.ToBytes())))
+
+	assert.Equal(t, ErrInvalidContractCode, decoded.FromBytes([]byte{0, 0}))
+	assert.Equal(t, ErrInvalidContractCode, decoded.FromBytes([]byte{0, 0, 0, 3, 'j', 's'}))
+}
+
+func TestBlock_Sandbox(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	countKeys := func() int {
+		iter := bc.storage.NewIterator(nil)
+		defer iter.Release()
+		cnt := 0
+		for iter.Next() {
+			cnt++
+		}
+		return cnt
+	}
+	keys := countKeys()
+
+	sandbox, err := bc.tailBlock.sandbox()
+	assert.Nil(t, err)
+	sandbox.begin()
+	sandbox.accState.GetOrCreateUserAccount([]byte("account")).AddBalance(util.NewUint128FromInt(1))
+	_, err = sandbox.txsTrie.Put([]byte("tx"), []byte("tx"))
+	assert.Nil(t, err)
+	assert.Nil(t, sandbox.RecordEvent([]byte("tx"), "topic", "data"))
+	_, err = storeContractCode(sandbox.storage, &ContractCode{SourceType: "js", Source: "source"})
+	assert.Nil(t, err)
+	sandbox.commit()
+	assert.NotEqual(t, bc.tailBlock.StateRoot(), sandbox.accState.RootHash())
+
+	// nothing is written to the storage of the chain.
+	assert.Equal(t, keys, countKeys())
+	assert.Equal(t, "0", bc.tailBlock.GetBalance([]byte("account")).String())
+}
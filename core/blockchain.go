@@ -19,6 +19,7 @@
 package core
 
 import (
+	"encoding/json"
 	"errors"
 	"strconv"
 	"strings"
@@ -26,6 +27,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/state"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
@@ -42,6 +44,9 @@ type BlockChain struct {
 	genesisBlock *Block
 	tailBlock    *Block
 
+	// lib is the last irreversible block, the chain never reverts below it.
+	lib *Block
+
 	bkPool           *BlockPool
 	txPool           *TransactionPool
 	consensusHandler Consensus
@@ -53,6 +58,11 @@ type BlockChain struct {
 	neb     Neblet
 
 	eventEmitter *EventEmitter
+
+	// statePruner is nil in archive mode, which keeps all history states.
+	statePruner *StatePruner
+
+	gasPriceOracle *GasPriceOracle
 }
 
 const (
@@ -64,6 +74,21 @@ const (
 
 	// Tail Key in storage
 	Tail = "blockchain_tail"
+
+	// LIB Key of the last irreversible block in storage
+	LIB = "blockchain_lib"
+
+	// HeightIndexPrefix Key prefix of the canonical height index in storage
+	HeightIndexPrefix = "blockchain_height_"
+
+	// TxIndexPrefix Key prefix of the canonical transaction index in storage
+	TxIndexPrefix = "blockchain_tx_"
+
+	// PrunedHeight Key of the height up to which the states are pruned in storage
+	PrunedHeight = "blockchain_pruned_height"
+
+	// TxJournalPrefix Key prefix of the locally submitted transactions in storage
+	TxJournalPrefix = "txpool_journal_"
 )
 
 var (
@@ -71,6 +96,7 @@ var (
 	blocktailHashGauge    = metrics.GetOrRegisterGauge("blocktail_hash", nil)
 	blockRevertTimesGauge = metrics.GetOrRegisterGauge("block_revert_count", nil)
 	blockRevertMeter      = metrics.GetOrRegisterMeter("block_revert", nil)
+	blockLIBHeightGauge   = metrics.GetOrRegisterGauge("block_lib_height", nil)
 )
 
 // NewBlockChain create new #BlockChain instance.
@@ -80,7 +106,7 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 		chainID:      neb.Genesis().Meta.ChainId,
 		genesis:      neb.Genesis(),
 		bkPool:       NewBlockPool(),
-		txPool:       NewTransactionPool(4096),
+		txPool:       NewTransactionPool(TransactionPoolSize),
 		storage:      neb.Storage(),
 		neb:          neb,
 		eventEmitter: neb.EventEmitter(),
@@ -100,8 +126,23 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 		return nil, err
 	}
 
+	bc.lib, err = bc.loadLIBFromStorage()
+	if err != nil {
+		return nil, err
+	}
+
+	// fill the height index of the chains stored before it exists.
+	batch := bc.storage.NewBatch()
+	if err := bc.storeHeightIndexToStorage(batch, bc.tailBlock, bc.tailBlock); err != nil {
+		return nil, err
+	}
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+
 	bc.bkPool.setBlockChain(bc)
 	bc.txPool.setBlockChain(bc)
+	bc.gasPriceOracle = NewGasPriceOracle(bc)
 
 	return bc, nil
 }
@@ -131,6 +172,51 @@ func (bc *BlockChain) TailBlock() *Block {
 	return bc.tailBlock
 }
 
+// LIB return the last irreversible block.
+func (bc *BlockChain) LIB() *Block {
+	return bc.lib
+}
+
+// DescendsFromLIB return if the block is built on the last irreversible block.
+// the canonical chain descends from lib, so only the blocks forking from it are walked.
+func (bc *BlockChain) DescendsFromLIB(block *Block) bool {
+	for block != nil && block.Height() >= bc.lib.Height() {
+		hash, err := bc.storage.Get(heightIndexKey(block.Height()))
+		if err == nil && block.Hash().Equals(hash) {
+			return true
+		}
+		block = bc.GetBlock(block.ParentHash())
+	}
+	return false
+}
+
+// findLIB return the highest block in the canonical chain ending at tail, on top of which more
+// than 2/3 of the dynasty members have minted blocks within a dynasty interval, or the current lib.
+func (bc *BlockChain) findLIB(tail *Block) *Block {
+	miners := make(map[string]bool)
+	for block := tail; block != nil && block.Height() > bc.lib.Height(); block = bc.GetBlock(block.ParentHash()) {
+		if len(miners) >= ConsensusSize {
+			return block
+		}
+		if tail.Timestamp()-block.Timestamp() > DynastyInterval {
+			break
+		}
+		miner, err := block.recoverMiner()
+		if err != nil {
+			log.WithFields(log.Fields{
+				"func":  "BlockChain.findLIB",
+				"block": block,
+				"err":   err,
+			}).Debug("Failed to recover the miner of block.")
+			continue
+		}
+		if block.inDynasty(miner) {
+			miners[miner.String()] = true
+		}
+	}
+	return bc.lib
+}
+
 // EventEmitter return the eventEmitter.
 func (bc *BlockChain) EventEmitter() *EventEmitter {
 	return bc.eventEmitter
@@ -139,9 +225,57 @@ func (bc *BlockChain) EventEmitter() *EventEmitter {
 // SetTailBlock set tail block.
 func (bc *BlockChain) SetTailBlock(newTail *Block) error {
 	oldTail := bc.tailBlock
+	if !bc.DescendsFromLIB(newTail) {
+		log.WithFields(log.Fields{
+			"func":    "BlockChain.SetTailBlock",
+			"newTail": newTail,
+			"lib":     bc.lib,
+		}).Error("refuse to revert the last irreversible block")
+		return ErrRevertIrreversibleBlock
+	}
 	bc.detachedTailBlocks.Remove(newTail.Hash().Hex())
+
+	// the tail, the lib and the canonical indexes are updated atomically.
+	batch := bc.storage.NewBatch()
+	if err := bc.storeTailToStorage(batch, newTail); err != nil {
+		return err
+	}
+	lib := bc.findLIB(newTail)
+	if err := bc.storeLIBToStorage(batch, lib); err != nil {
+		return err
+	}
+	if err := bc.storeHeightIndexToStorage(batch, oldTail, newTail); err != nil {
+		log.WithFields(log.Fields{
+			"func":    "BlockChain.SetTailBlock",
+			"oldTail": oldTail,
+			"newTail": newTail,
+			"err":     err,
+		}).Error("failed to update height index")
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
 	bc.tailBlock = newTail
-	bc.storeTailToStorage(bc.tailBlock)
+	bc.txPool.reset(newTail)
+	if lib != bc.lib {
+		bc.lib = lib
+		blockLIBHeightGauge.Update(int64(lib.Height()))
+		log.WithFields(log.Fields{
+			"func": "BlockChain.SetTailBlock",
+			"lib":  lib,
+		}).Debug("last irreversible block changed")
+	}
+
+	if bc.statePruner != nil {
+		if err := bc.statePruner.Prune(newTail); err != nil {
+			log.WithFields(log.Fields{
+				"func":    "BlockChain.SetTailBlock",
+				"newTail": newTail,
+				"err":     err,
+			}).Error("failed to prune states")
+		}
+	}
 	// giveBack txs in reverted blocks to tx pool
 	ancestor, err := bc.FindCommonAncestorWithTail(oldTail)
 	if err != nil {
@@ -156,25 +290,70 @@ func (bc *BlockChain) SetTailBlock(newTail *Block) error {
 		if err == nil {
 			blocktailHashGauge.Update(hash)
 		}
+		bc.triggerTailEvents(oldTail, newTail, ancestor, nil)
 		return nil
 	}
-	reverted := oldTail
-	var revertTimes int64
-	for revertTimes = 0; !reverted.Hash().Equals(ancestor.Hash()); {
-		revertTimes++
-		reverted.ReturnTransactions()
-		reverted = bc.GetBlock(reverted.header.parentHash)
-		if reverted == nil {
+	var reverted []*Block
+	for block := oldTail; !block.Hash().Equals(ancestor.Hash()); {
+		block.ReturnTransactions()
+		if bc.statePruner != nil {
+			bc.statePruner.AddStaleBlock(block)
+		}
+		reverted = append(reverted, block)
+		block = bc.GetBlock(block.header.parentHash)
+		if block == nil {
 			return ErrMissingParentBlock
 		}
 	}
-	if revertTimes > 0 {
-		blockRevertTimesGauge.Update(revertTimes)
-		blockRevertMeter.Mark(1)
+	if len(reverted) > 0 {
+		blockRevertTimesGauge.Update(int64(len(reverted)))
+		blockRevertMeter.Mark(int64(len(reverted)))
 	}
+	bc.triggerTailEvents(oldTail, newTail, ancestor, reverted)
 	return nil
 }
 
+// triggerTailEvents emit the new tail, and on a reorg first the reverted blocks from
+// the old tail down, the reapplied blocks from the common ancestor up and the reorg.
+// The events are dropped instead of blocking when the emitter is busy.
+func (bc *BlockChain) triggerTailEvents(oldTail, newTail, ancestor *Block, reverted []*Block) {
+	trigger := func(topic string, block *Block) {
+		data, err := json.Marshal(&ReorgEvent{
+			Hash:           block.Hash().String(),
+			Height:         block.Height(),
+			OldTail:        oldTail.Hash().String(),
+			NewTail:        newTail.Hash().String(),
+			CommonAncestor: ancestor.Hash().String(),
+			Depth:          int64(len(reverted)),
+		})
+		if err != nil {
+			return
+		}
+		// the tail is set already, it is not blocked by slow subscribers.
+		if !bc.eventEmitter.TryTrigger(&Event{Topic: topic, Data: string(data)}) {
+			log.WithFields(log.Fields{
+				"func":  "BlockChain.triggerTailEvents",
+				"topic": topic,
+				"block": block,
+			}).Warn("Event emitter is busy, drop the tail event.")
+		}
+	}
+	if len(reverted) > 0 {
+		var reapplied []*Block
+		for block := newTail; block != nil && !block.Hash().Equals(ancestor.Hash()); block = bc.GetBlock(block.ParentHash()) {
+			reapplied = append([]*Block{block}, reapplied...)
+		}
+		for _, block := range reverted {
+			trigger(TopicRevertBlock, block)
+		}
+		for _, block := range reapplied {
+			trigger(TopicReapplyBlock, block)
+		}
+		trigger(TopicChainReorg, newTail)
+	}
+	trigger(TopicNewTailBlock, newTail)
+}
+
 func hashToInt64(hash string) (int64, error) {
 	rs := []rune(hash)
 	h := string(rs[len(hash)-4 : len(hash)])
@@ -222,27 +401,20 @@ func (bc *BlockChain) FindCommonAncestorWithTail(block *Block) (*Block, error) {
 }
 
 // FetchDescendantInCanonicalChain return the subsequent blocks of the block
-// lookup the block's descendant from tail to genesis
+// lookup the block's descendant by the canonical height index
 // if the block is not in canonical chain, return err
 func (bc *BlockChain) FetchDescendantInCanonicalChain(n int, block *Block) ([]*Block, error) {
-	curIdx := -1
-	queue := make([]*Block, n)
-	// get tail in canonical chain
-	curBlock := bc.tailBlock
-	for curBlock != nil && !curBlock.Hash().Equals(block.Hash()) {
-		if CheckGenesisBlock(curBlock) {
-			return nil, errors.New("cannot find the block in canonical chain")
-		}
-		curIdx = (curIdx + 1) % n
-		queue[curIdx] = curBlock
-		curBlock = bc.GetBlock(curBlock.header.parentHash)
+	canonical := bc.GetBlockByHeight(block.Height())
+	if canonical == nil || !canonical.Hash().Equals(block.Hash()) {
+		return nil, errors.New("cannot find the block in canonical chain")
 	}
 	var res []*Block
-	for i := 0; curIdx >= 0 && i < n; i++ {
-		if queue[curIdx] != nil {
-			res = append(res, queue[curIdx])
+	for height := block.Height() + 1; len(res) < n && height <= bc.tailBlock.Height(); height++ {
+		descendant := bc.GetBlockByHeight(height)
+		if descendant == nil {
+			return nil, ErrMissingParentBlock
 		}
-		curIdx = (curIdx + n - 1) % n
+		res = append(res, descendant)
 	}
 	return res, nil
 }
@@ -279,11 +451,22 @@ func (bc *BlockChain) NewBlockFromParent(coinbase *Address, parentBlock *Block)
 
 // PutVerifiedNewBlocks put verified new blocks and tails.
 func (bc *BlockChain) PutVerifiedNewBlocks(allBlocks, tailBlocks []*Block) error {
+	// the blocks are written with the state changes buffered in their storage.
+	batch := bc.storage.NewBatch()
 	for _, v := range allBlocks {
-		bc.cachedBlocks.ContainsOrAdd(v.Hash().Hex(), v)
-		if err := bc.storeBlockToStorage(v); err != nil {
+		if err := v.flush(batch); err != nil {
 			return err
 		}
+		if err := bc.storeBlockToStorage(batch, v); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	for _, v := range allBlocks {
+		v.flushed(bc.storage)
+		bc.cachedBlocks.ContainsOrAdd(v.Hash().Hex(), v)
 	}
 	for _, v := range tailBlocks {
 		bc.detachedTailBlocks.ContainsOrAdd(v.Hash().Hex(), v)
@@ -304,6 +487,31 @@ func (bc *BlockChain) DetachedTailBlocks() []*Block {
 	return ret
 }
 
+// EnableStatePruning prune the stale states, keep the states of the latest keepBlocks blocks
+// and the checkpoints at multiples of checkpointInterval. zero values mean the defaults.
+func (bc *BlockChain) EnableStatePruning(keepBlocks, checkpointInterval uint64) error {
+	pruner, err := NewStatePruner(bc, keepBlocks, checkpointInterval)
+	if err != nil {
+		return err
+	}
+	bc.statePruner = pruner
+	return nil
+}
+
+// StatePruner return the state pruner, nil in archive mode.
+func (bc *BlockChain) StatePruner() *StatePruner {
+	return bc.statePruner
+}
+
+// StateAt open the account state on the state root of block,
+// return ErrStatePruned if the state of block was pruned.
+func (bc *BlockChain) StateAt(block *Block) (state.AccountState, error) {
+	if bc.statePruner != nil && bc.statePruner.IsPruned(block.Height()) {
+		return nil, ErrStatePruned
+	}
+	return state.NewAccountState(block.StateRoot(), bc.storage)
+}
+
 // GetBlock return block of given hash from local storage and detachedBlocks.
 func (bc *BlockChain) GetBlock(hash byteutils.Hash) *Block {
 	// TODO: get block from local storage.
@@ -320,57 +528,140 @@ func (bc *BlockChain) GetBlock(hash byteutils.Hash) *Block {
 	return block
 }
 
-// GetTransaction return transaction of given hash from local storage.
+// GetBlockByHeight return block of given height in canonical chain.
+func (bc *BlockChain) GetBlockByHeight(height uint64) *Block {
+	if height > bc.tailBlock.Height() {
+		return nil
+	}
+	hash, err := bc.storage.Get(heightIndexKey(height))
+	if err != nil {
+		return nil
+	}
+	return bc.GetBlock(hash)
+}
+
+// GetTransaction return transaction of given hash from canonical chain.
 func (bc *BlockChain) GetTransaction(hash byteutils.Hash) *Transaction {
-	// TODO: get transaction err handle.
-	tx, err := bc.tailBlock.GetTransaction(hash)
+	block, index, err := bc.loadTxIndexFromStorage(hash)
+	if err != nil {
+		return nil
+	}
+	return block.transactions[index]
+}
+
+// GetTransactionBlock return the block in canonical chain which packs the transaction of given hash.
+func (bc *BlockChain) GetTransactionBlock(hash byteutils.Hash) *Block {
+	block, _, err := bc.loadTxIndexFromStorage(hash)
 	if err != nil {
 		return nil
 	}
-	return tx
+	return block
+}
+
+// GasPriceOracle return the gasPrice oracle.
+func (bc *BlockChain) GasPriceOracle() *GasPriceOracle {
+	return bc.gasPriceOracle
 }
 
-// GasPrice returns the lowest transaction gas price.
+// GasPrice returns the gasPrice suggested by the oracle.
 func (bc *BlockChain) GasPrice() *util.Uint128 {
-	gasPrice := TransactionMaxGasPrice
-	tailBlock := bc.tailBlock
-	for {
-		// if the block is genesis, stop find the parent block
-		if tailBlock.Hash().Equals(GenesisHash) {
-			break
-		}
+	return bc.gasPriceOracle.GasPrices().Suggested
+}
 
-		if len(tailBlock.transactions) > 0 {
-			break
+// EstimateGasResult the result of a dry run of a transaction.
+type EstimateGasResult struct {
+	GasUsed *util.Uint128
+	Err     error
+}
+
+// EstimateGas execute tx on a sandbox of the tail block with the sender's nonce and balance checks relaxed,
+// and returns the exact gas used and the execution error if the tx would fail. The tail is never changed.
+func (bc *BlockChain) EstimateGas(tx *Transaction) (*EstimateGasResult, error) {
+	var err error
+	if tx.hash == nil {
+		if tx.hash, err = HashTransaction(tx); err != nil {
+			return nil, err
 		}
-		tailBlock = bc.GetBlock(tailBlock.ParentHash())
+	}
+	sandbox, err := bc.tailBlock.sandbox()
+	if err != nil {
+		return nil, err
 	}
 
-	if len(tailBlock.transactions) > 0 {
-		for _, tx := range tailBlock.transactions {
-			if tx.gasPrice.Cmp(gasPrice.Int) < 0 {
-				gasPrice = tx.gasPrice
-			}
+	// update gas to max for estimate, and give the sender enough balance to pay for it.
+	estimate := *tx
+	estimate.gasLimit = TransactionMaxGas
+	fromAcc := sandbox.accState.GetOrCreateUserAccount(tx.from.address)
+	fromAcc.AddBalance(estimate.Cost())
+
+	gasUsed, err := estimate.Execute(sandbox)
+	if err != nil {
+		return nil, err
+	}
+	result := &EstimateGasResult{GasUsed: gasUsed}
+	events, err := sandbox.FetchEvents(tx.hash)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		if event.Topic != TopicExecuteTxFailed {
+			continue
+		}
+		txEvent := new(TransactionEvent)
+		if err := json.Unmarshal([]byte(event.Data), txEvent); err != nil {
+			return nil, err
 		}
-	} else {
-		// if no transactions have been submited, use the default gasPrice
-		gasPrice = TransactionGasPrice
+		result.Err = errors.New(txEvent.Error)
 	}
-
-	return gasPrice
+	return result, nil
 }
 
-// EstimateGas returns the transaction gas cost
-func (bc *BlockChain) EstimateGas(tx *Transaction) (*util.Uint128, error) {
+// SimulateCallResult the result of a simulated contract call.
+type SimulateCallResult struct {
+	Result  string
+	GasUsed *util.Uint128
+	Events  []*Event
+	Err     error
+}
 
-	// update gas to max for estimate
-	tx.gasLimit = TransactionMaxGas
+// SimulateCall execute the contract call in tx on the state of block, all changes are discarded.
+func (bc *BlockChain) SimulateCall(tx *Transaction, block *Block) (*SimulateCallResult, error) {
+	if tx.data.Type != TxPayloadCallType {
+		return nil, ErrNotCallTransaction
+	}
+	payload, err := LoadCallPayload(tx.data.Payload)
+	if err != nil {
+		return nil, err
+	}
+	gasUsed := tx.CalculateGas()
+	if tx.gasLimit.Cmp(gasUsed.Int) < 0 {
+		return nil, ErrOutofGasLimit
+	}
+	// events of the call are recorded by tx hash.
+	if tx.hash == nil {
+		if tx.hash, err = HashTransaction(tx); err != nil {
+			return nil, err
+		}
+	}
 
-	bc.tailBlock.accState.BeginBatch()
-	fromAcc := bc.tailBlock.accState.GetOrCreateUserAccount(tx.from.address)
-	fromAcc.AddBalance(tx.Cost())
-	defer bc.tailBlock.accState.RollBack()
-	return tx.Execute(bc.tailBlock)
+	sandbox, err := block.sandbox()
+	if err != nil {
+		return nil, err
+	}
+	gasExecution, result, exeErr := payload.Execute(tx, sandbox)
+	if gasExecution != nil {
+		gasUsed.Add(gasUsed.Int, gasExecution.Int)
+	}
+	events, err := sandbox.FetchEvents(tx.hash)
+	if err != nil {
+		return nil, err
+	}
+	return &SimulateCallResult{
+		Result:  result,
+		GasUsed: gasUsed,
+		Events:  events,
+		Err:     exeErr,
+	}, nil
 }
 
 func (bc *BlockChain) getAncestorHash(number int) byteutils.Hash {
@@ -405,7 +696,7 @@ func (bc *BlockChain) Dump(count int) string {
 	return rls
 }
 
-func (bc *BlockChain) storeBlockToStorage(block *Block) error {
+func (bc *BlockChain) storeBlockToStorage(batch storage.Batch, block *Block) error {
 	pbBlock, err := block.ToProto()
 	if err != nil {
 		return err
@@ -414,15 +705,106 @@ func (bc *BlockChain) storeBlockToStorage(block *Block) error {
 	if err != nil {
 		return err
 	}
-	err = bc.storage.Put(block.Hash(), value)
-	if err != nil {
-		return err
+	return batch.Put(block.Hash(), value)
+}
+
+func (bc *BlockChain) storeTailToStorage(batch storage.Batch, block *Block) error {
+	return batch.Put([]byte(Tail), block.Hash())
+}
+
+func (bc *BlockChain) storeLIBToStorage(batch storage.Batch, block *Block) error {
+	return batch.Put([]byte(LIB), block.Hash())
+}
+
+func heightIndexKey(height uint64) []byte {
+	return append([]byte(HeightIndexPrefix), byteutils.FromUint64(height)...)
+}
+
+// storeHeightIndexToStorage point the height index to the canonical chain ending at newTail.
+// entries above newTail left by oldTail are removed, and entries are rewritten
+// from newTail back to the first height already pointing to the canonical chain.
+func (bc *BlockChain) storeHeightIndexToStorage(batch storage.Batch, oldTail, newTail *Block) error {
+	for height := newTail.Height() + 1; height <= oldTail.Height(); height++ {
+		if err := batch.Del(heightIndexKey(height)); err != nil {
+			return err
+		}
+	}
+
+	block := newTail
+	for {
+		key := heightIndexKey(block.Height())
+		hash, err := bc.storage.Get(key)
+		if err != nil && err != storage.ErrKeyNotFound {
+			return err
+		}
+		if err == nil && block.Hash().Equals(hash) {
+			return nil
+		}
+		if err := batch.Put(key, block.Hash()); err != nil {
+			return err
+		}
+		if err := bc.storeTxIndexToStorage(batch, block); err != nil {
+			return err
+		}
+		if CheckGenesisBlock(block) {
+			return nil
+		}
+		block = bc.GetBlock(block.ParentHash())
+		if block == nil {
+			return ErrMissingParentBlock
+		}
+	}
+}
+
+func txIndexKey(hash byteutils.Hash) []byte {
+	return append([]byte(TxIndexPrefix), hash...)
+}
+
+// storeTxIndexToStorage point the transactions in block to it, called when block become canonical.
+func (bc *BlockChain) storeTxIndexToStorage(batch storage.Batch, block *Block) error {
+	for idx, tx := range block.transactions {
+		value, err := proto.Marshal(&corepb.TransactionIndex{
+			BlockHash: block.Hash(),
+			Index:     uint64(idx),
+		})
+		if err != nil {
+			return err
+		}
+		if err := batch.Put(txIndexKey(tx.hash), value); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-func (bc *BlockChain) storeTailToStorage(block *Block) {
-	bc.storage.Put([]byte(Tail), block.Hash())
+// loadTxIndexFromStorage return the canonical block and the index of transaction in it.
+func (bc *BlockChain) loadTxIndexFromStorage(hash byteutils.Hash) (*Block, int, error) {
+	value, err := bc.storage.Get(txIndexKey(hash))
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return nil, 0, ErrTransactionNotFound
+		}
+		return nil, 0, err
+	}
+	pbIndex := new(corepb.TransactionIndex)
+	if err := proto.Unmarshal(value, pbIndex); err != nil {
+		return nil, 0, err
+	}
+
+	// the block may be reverted after the index is written.
+	block := bc.GetBlock(pbIndex.BlockHash)
+	if block == nil {
+		return nil, 0, ErrTransactionNotFound
+	}
+	canonical := bc.GetBlockByHeight(block.Height())
+	if canonical == nil || !canonical.Hash().Equals(block.Hash()) {
+		return nil, 0, ErrTransactionNotFound
+	}
+	index := int(pbIndex.Index)
+	if index >= len(block.transactions) || !block.transactions[index].hash.Equals(hash) {
+		return nil, 0, ErrTransactionNotFound
+	}
+	return block, index, nil
 }
 
 func (bc *BlockChain) loadTailFromStorage() (*Block, error) {
@@ -437,7 +819,13 @@ func (bc *BlockChain) loadTailFromStorage() (*Block, error) {
 			return nil, err
 		}
 
-		bc.storeTailToStorage(genesis)
+		batch := bc.storage.NewBatch()
+		if err := bc.storeTailToStorage(batch, genesis); err != nil {
+			return nil, err
+		}
+		if err := batch.Write(); err != nil {
+			return nil, err
+		}
 
 		return genesis, nil
 	}
@@ -445,6 +833,18 @@ func (bc *BlockChain) loadTailFromStorage() (*Block, error) {
 	return LoadBlockFromStorage(hash, bc.storage, bc.txPool, bc.eventEmitter)
 }
 
+// loadLIBFromStorage return the stored lib, or the genesis for the chains stored before it exists.
+func (bc *BlockChain) loadLIBFromStorage() (*Block, error) {
+	hash, err := bc.storage.Get([]byte(LIB))
+	if err == storage.ErrKeyNotFound {
+		return bc.genesisBlock, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return LoadBlockFromStorage(hash, bc.storage, bc.txPool, bc.eventEmitter)
+}
+
 func (bc *BlockChain) loadGenesisFromStorage() (*Block, error) {
 	genesis, err := LoadBlockFromStorage(GenesisHash, bc.storage, bc.txPool, bc.eventEmitter)
 	if err == nil {
@@ -458,7 +858,11 @@ func (bc *BlockChain) loadGenesisFromStorage() (*Block, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := bc.storeBlockToStorage(genesis); err != nil {
+	batch := bc.storage.NewBatch()
+	if err := bc.storeBlockToStorage(batch, genesis); err != nil {
+		return nil, err
+	}
+	if err := batch.Write(); err != nil {
 		return nil, err
 	}
 
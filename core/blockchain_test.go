@@ -19,13 +19,17 @@
 package core
 
 import (
+	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -103,9 +107,12 @@ func TestBlockChain_FindCommonAncestorWithTail(t *testing.T) {
 	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block11)))
 	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block12)))
 	bc.SetTailBlock(block12)
-	assert.Equal(t, bc.txPool.cache.Len(), 1)
+	// tx3 waits for tx1 mined in block11.
+	assert.Equal(t, len(bc.txPool.all), 1)
+	assert.True(t, bc.txPool.Empty())
 	bc.SetTailBlock(block11)
-	assert.Equal(t, bc.txPool.cache.Len(), 2)
+	assert.Equal(t, len(bc.txPool.all), 1)
+	assert.False(t, bc.txPool.Empty())
 	block111, _ := bc.NewBlock(coinbase111)
 	block111.header.timestamp = BlockInterval * 4
 	block111.CollectTransactions(0)
@@ -208,6 +215,322 @@ func TestBlockChain_FetchDescendantInCanonicalChain(t *testing.T) {
 	assert.Nil(t, err0)
 }
 
+func TestBlockChain_GetBlockByHeight(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+	/*
+		genesis -- 1 - 2 - 3
+		        \_ fork1 - fork2 - fork3 - fork4
+	*/
+	var blocks []*Block
+	for i := 0; i < 3; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(block))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+	assert.Equal(t, bc.GetBlockByHeight(1).Hash(), bc.genesisBlock.Hash())
+	for _, block := range blocks {
+		assert.Equal(t, bc.GetBlockByHeight(block.Height()).Hash(), block.Hash())
+	}
+	assert.Nil(t, bc.GetBlockByHeight(blocks[2].Height()+1))
+
+	parent := bc.genesisBlock
+	var forks []*Block
+	for i := 0; i < 4; i++ {
+		block, _ := bc.NewBlockFromParent(coinbase, parent)
+		block.header.timestamp = BlockInterval * int64(i+10)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(block))
+		forks = append(forks, block)
+		parent = block
+	}
+	assert.Nil(t, bc.SetTailBlock(forks[3]))
+	for _, block := range forks {
+		assert.Equal(t, bc.GetBlockByHeight(block.Height()).Hash(), block.Hash())
+	}
+
+	assert.Nil(t, bc.SetTailBlock(blocks[2]))
+	for _, block := range blocks {
+		assert.Equal(t, bc.GetBlockByHeight(block.Height()).Hash(), block.Hash())
+	}
+	assert.Nil(t, bc.GetBlockByHeight(forks[3].Height()))
+}
+
+func TestBlockChain_PutVerifiedNewBlocks(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	block, _ := bc.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval
+	block.CollectTransactions(0)
+	block.SetMiner(coinbase)
+	assert.Nil(t, block.Seal())
+	assert.NotEqual(t, bc.tailBlock.StateRoot(), block.StateRoot())
+
+	// the state of the block is written with it.
+	_, err := bc.storage.Get(block.StateRoot())
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Nil(t, bc.PutVerifiedNewBlocks([]*Block{block}, []*Block{block}))
+	_, err = bc.storage.Get(block.StateRoot())
+	assert.Nil(t, err)
+
+	stored, err := LoadBlockFromStorage(block.Hash(), bc.storage, bc.txPool, bc.eventEmitter)
+	assert.Nil(t, err)
+	assert.Equal(t, block.GetBalance(coinbase.Bytes()), stored.GetBalance(coinbase.Bytes()))
+}
+
+func TestBlockChain_ReorgEvents(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+	/*
+		genesis -- 1 - 2
+		        \_ fork1 - fork2 - fork3
+	*/
+	var blocks []*Block
+	for i := 0; i < 2; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(block))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+	parent := bc.genesisBlock
+	var forks []*Block
+	for i := 0; i < 3; i++ {
+		block, _ := bc.NewBlockFromParent(coinbase, parent)
+		block.header.timestamp = BlockInterval * int64(i+10)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(block))
+		forks = append(forks, block)
+		parent = block
+	}
+
+	// only the events of the reorg are watched.
+	emitter := NewEventEmitter()
+	bc.eventEmitter = emitter
+	eventCh := make(chan *Event, 16)
+	topics := []string{TopicNewTailBlock, TopicChainReorg, TopicRevertBlock, TopicReapplyBlock}
+	for _, topic := range topics {
+		emitter.Register(topic, eventCh)
+	}
+	emitter.Start()
+	defer emitter.Stop()
+
+	assert.Nil(t, bc.SetTailBlock(forks[2]))
+	expected := []struct {
+		topic string
+		block *Block
+	}{
+		{TopicRevertBlock, blocks[1]},
+		{TopicRevertBlock, blocks[0]},
+		{TopicReapplyBlock, forks[0]},
+		{TopicReapplyBlock, forks[1]},
+		{TopicReapplyBlock, forks[2]},
+		{TopicChainReorg, forks[2]},
+		{TopicNewTailBlock, forks[2]},
+	}
+	for _, e := range expected {
+		event := <-eventCh
+		assert.Equal(t, event.Topic, e.topic)
+		data := new(ReorgEvent)
+		assert.Nil(t, json.Unmarshal([]byte(event.Data), data))
+		assert.Equal(t, data.Hash, e.block.Hash().String())
+		assert.Equal(t, data.Height, e.block.Height())
+		assert.Equal(t, data.OldTail, blocks[1].Hash().String())
+		assert.Equal(t, data.NewTail, forks[2].Hash().String())
+		assert.Equal(t, data.CommonAncestor, bc.genesisBlock.Hash().String())
+		assert.Equal(t, data.Depth, int64(2))
+	}
+
+	block, _ := bc.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval * 20
+	block.CollectTransactions(0)
+	block.SetMiner(coinbase)
+	block.Seal()
+	assert.Nil(t, bc.BlockPool().Push(block))
+	assert.Nil(t, bc.SetTailBlock(block))
+	event := <-eventCh
+	assert.Equal(t, event.Topic, TopicNewTailBlock)
+	data := new(ReorgEvent)
+	assert.Nil(t, json.Unmarshal([]byte(event.Data), data))
+	assert.Equal(t, data.Depth, int64(0))
+	assert.Equal(t, data.CommonAncestor, forks[2].Hash().String())
+}
+
+func TestBlockChain_BusyEventEmitter(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+	block, _ := bc.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval
+	block.CollectTransactions(0)
+	block.SetMiner(coinbase)
+	block.Seal()
+	assert.Nil(t, bc.BlockPool().Push(block))
+
+	// the emitter is not started, its buffer is full.
+	emitter := NewEventEmitter()
+	bc.eventEmitter = emitter
+	for emitter.TryTrigger(&Event{Topic: TopicNewTailBlock}) {
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- bc.SetTailBlock(block)
+	}()
+	select {
+	case err := <-done:
+		assert.Nil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("SetTailBlock is blocked by the event emitter.")
+	}
+	assert.Equal(t, bc.TailBlock().Hash(), block.Hash())
+}
+
+func TestBlockChain_LIB(t *testing.T) {
+	neb := testNeb()
+	bc, _ := NewBlockChain(neb)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	assert.Equal(t, bc.LIB().Hash(), bc.genesisBlock.Hash())
+	/*
+		genesis -- 1 - 2 - 3 - 4 - 5 - 6
+		        \_ fork1 - ... - fork7
+	*/
+	member, _ := AddressParse(MockDynasty[0])
+	assert.True(t, bc.genesisBlock.inDynasty(member))
+	assert.False(t, bc.genesisBlock.inDynasty(&Address{[]byte(fmt.Sprintf("01234567890123456789%04d", 0))}))
+
+	var blocks []*Block
+	for i := 0; i < DynastySize; i++ {
+		coinbase, _ := AddressParse(MockDynasty[i])
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(block))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+	// miners of the blocks 2 - 6 built on top of the block 1.
+	assert.Equal(t, bc.LIB().Hash(), blocks[0].Hash())
+	assert.True(t, bc.DescendsFromLIB(blocks[5]))
+	uncle, _ := bc.NewBlockFromParent(blocks[5].Coinbase(), blocks[0])
+	uncle.header.timestamp = BlockInterval * int64(DynastySize+1)
+	uncle.CollectTransactions(0)
+	uncle.Seal()
+	assert.True(t, bc.DescendsFromLIB(uncle))
+
+	coinbase, _ := AddressParse(MockDynasty[0])
+	parent := bc.genesisBlock
+	var forks []*Block
+	for i := 0; i < DynastySize+1; i++ {
+		block, _ := bc.NewBlockFromParent(coinbase, parent)
+		block.header.timestamp = BlockInterval * int64(i+10)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(block))
+		forks = append(forks, block)
+		parent = block
+	}
+	assert.False(t, bc.DescendsFromLIB(forks[DynastySize]))
+	assert.Equal(t, bc.SetTailBlock(forks[DynastySize]), ErrRevertIrreversibleBlock)
+	assert.Equal(t, bc.TailBlock().Hash(), blocks[5].Hash())
+
+	restarted, _ := NewBlockChain(neb)
+	assert.Equal(t, restarted.LIB().Hash(), blocks[0].Hash())
+}
+
+func TestBlockChain_GetTransaction(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	to := &Address{from.address}
+	ks.SetKey(from.String(), priv, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	//add from reward
+	block0, _ := bc.NewBlock(from)
+	block0.header.timestamp = BlockInterval
+	block0.SetMiner(from)
+	block0.Seal()
+	assert.Nil(t, bc.BlockPool().Push(block0))
+	assert.Nil(t, bc.SetTailBlock(block0))
+
+	tx := NewTransaction(0, from, to, util.NewUint128FromInt(1), 1, TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	tx.Sign(signature)
+	assert.Nil(t, bc.txPool.Push(tx))
+	assert.Nil(t, bc.GetTransaction(tx.Hash()))
+
+	/*
+		genesis -- 0 -- 1 tail
+		             \_ fork1 -- fork2
+	*/
+	block1, _ := bc.NewBlock(from)
+	block1.header.timestamp = BlockInterval * 2
+	block1.CollectTransactions(1)
+	block1.SetMiner(from)
+	block1.Seal()
+	assert.Equal(t, len(block1.transactions), 1)
+	assert.Nil(t, bc.BlockPool().Push(block1))
+	assert.Nil(t, bc.SetTailBlock(block1))
+
+	assert.Equal(t, bc.GetTransaction(tx.Hash()).Hash(), tx.Hash())
+	assert.Equal(t, bc.GetTransactionBlock(tx.Hash()).Hash(), block1.Hash())
+	result, err := block1.FetchExecutionResultEvent(tx.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, result.Status, int8(TxExecutionSuccess))
+	assert.Equal(t, result.Error, "")
+	assert.NotEqual(t, result.GasUsed, "0")
+
+	parent := block0
+	var forks []*Block
+	for i := 0; i < 2; i++ {
+		block, _ := bc.NewBlockFromParent(from, parent)
+		block.header.timestamp = BlockInterval * int64(i+10)
+		block.SetMiner(from)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(block))
+		forks = append(forks, block)
+		parent = block
+	}
+	assert.Nil(t, bc.SetTailBlock(forks[1]))
+	assert.Nil(t, bc.GetTransaction(tx.Hash()))
+	assert.Nil(t, bc.GetTransactionBlock(tx.Hash()))
+
+	assert.Nil(t, bc.SetTailBlock(block1))
+	assert.Equal(t, bc.GetTransactionBlock(tx.Hash()).Hash(), block1.Hash())
+}
+
 func TestBlockChain_EstimateGas(t *testing.T) {
 	priv := secp256k1.GeneratePrivateKey()
 	pubdata, _ := priv.PublicKey().Encoded()
@@ -219,7 +542,160 @@ func TestBlockChain_EstimateGas(t *testing.T) {
 
 	tx := NewTransaction(0, from, to, util.NewUint128FromInt(0), 1, TxPayloadBinaryType, payload, TransactionGasPrice, util.NewUint128FromInt(200000))
 	bc, _ := NewBlockChain(testNeb())
+	tail := bc.TailBlock()
+	stateRoot := tail.accState.RootHash()
+	eventsRoot := tail.eventsTrie.RootHash()
+
+	result, err := bc.EstimateGas(tx)
+	assert.Nil(t, err)
+	assert.Nil(t, result.Err)
+	assert.Equal(t, result.GasUsed, tx.CalculateGas())
+	assert.Equal(t, tail.accState.RootHash(), stateRoot)
+	assert.Equal(t, tail.eventsTrie.RootHash(), eventsRoot)
+	assert.Equal(t, tx.GasLimit(), util.NewUint128FromInt(200000))
 
-	_, err = bc.EstimateGas(tx)
+	call, err := NewCallPayload("get", "").ToBytes()
+	assert.Nil(t, err)
+	tx = NewTransaction(0, from, to, util.NewUint128FromInt(0), 1, TxPayloadCallType, call, TransactionGasPrice, util.NewUint128FromInt(200000))
+	result, err = bc.EstimateGas(tx)
 	assert.Nil(t, err)
+	assert.NotNil(t, result.Err)
+	assert.Equal(t, tail.accState.RootHash(), stateRoot)
+	assert.Equal(t, tail.eventsTrie.RootHash(), eventsRoot)
+}
+
+func TestBlockChain_SimulateCall(t *testing.T) {
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	to := &Address{from.address}
+	bc, _ := NewBlockChain(testNeb())
+	tail := bc.TailBlock()
+
+	binary, err := NewBinaryPayload(nil).ToBytes()
+	assert.Nil(t, err)
+	tx := NewTransaction(0, from, to, util.NewUint128FromInt(0), 1, TxPayloadBinaryType, binary, TransactionGasPrice, util.NewUint128FromInt(200000))
+	_, err = bc.SimulateCall(tx, tail)
+	assert.Equal(t, err, ErrNotCallTransaction)
+
+	payload, err := NewCallPayload("get", "").ToBytes()
+	assert.Nil(t, err)
+	tx = NewTransaction(0, from, to, util.NewUint128FromInt(0), 1, TxPayloadCallType, payload, TransactionGasPrice, util.NewUint128FromInt(1))
+	_, err = bc.SimulateCall(tx, tail)
+	assert.Equal(t, err, ErrOutofGasLimit)
+
+	stateRoot := tail.accState.RootHash()
+	eventsRoot := tail.eventsTrie.RootHash()
+	tx = NewTransaction(0, from, to, util.NewUint128FromInt(0), 1, TxPayloadCallType, payload, TransactionGasPrice, util.NewUint128FromInt(200000))
+	result, err := bc.SimulateCall(tx, tail)
+	assert.Nil(t, err)
+	assert.NotNil(t, result.Err)
+	assert.Equal(t, result.GasUsed, tx.CalculateGas())
+	assert.Equal(t, tail.accState.RootHash(), stateRoot)
+	assert.Equal(t, tail.eventsTrie.RootHash(), eventsRoot)
+}
+
+// mineTransferBlocks mine blocks on the tail, every block transfers to a new account,
+// so the states of old blocks become stale. wait is called after each block.
+func mineTransferBlocks(t *testing.T, bc *BlockChain, n int, wait func()) *Address {
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	ks.SetKey(from.String(), priv, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			pubdata, _ := secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+			to, _ := NewAddressFromPublicKey(pubdata)
+			tx := NewTransaction(0, from, to, util.NewUint128FromInt(1), uint64(i), TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+			tx.Sign(signature)
+			assert.Nil(t, bc.txPool.Push(tx))
+		}
+		block, _ := bc.NewBlock(from)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(1)
+		block.SetMiner(from)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(block))
+		assert.Nil(t, bc.SetTailBlock(block))
+		wait()
+	}
+	return from
+}
+
+func TestBlockChain_StatePruning(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	assert.Nil(t, bc.StatePruner())
+	assert.Nil(t, bc.EnableStatePruning(32, 16))
+	pruner := bc.StatePruner()
+
+	from := mineTransferBlocks(t, bc, 100, pruner.wait)
+	assert.Equal(t, uint64(101), bc.TailBlock().Height())
+	assert.Equal(t, 1, len(bc.GetBlockByHeight(50).transactions))
+
+	// the rounds started at the tails of height 64 and 96 are finished.
+	assert.Equal(t, pruner.PrunedHeight(), uint64(64))
+	assert.False(t, pruner.IsPruned(1))
+	assert.True(t, pruner.IsPruned(2))
+	assert.False(t, pruner.IsPruned(16))
+	assert.False(t, pruner.IsPruned(65))
+
+	// the pruned height survives restart.
+	restarted, err := NewStatePruner(bc, 32, 16)
+	assert.Nil(t, err)
+	assert.Equal(t, restarted.PrunedHeight(), pruner.PrunedHeight())
+
+	// the stale nodes collected in the first round are deleted, the roots of pruned tries are kept.
+	walk := func(block *Block) error {
+		return walkBlockTries(bc.storage, block, func(hash byteutils.HexHash) bool { return true })
+	}
+	for _, height := range []uint64{2, 20, 31} {
+		block := bc.GetBlockByHeight(height)
+		assert.NotNil(t, block)
+		_, err := bc.storage.Get(block.StateRoot())
+		assert.Nil(t, err)
+		assert.Equal(t, storage.ErrKeyNotFound, walk(block))
+	}
+
+	// the states of the kept blocks are intact.
+	for _, height := range []uint64{1, 16, 32, 48, 64, 70, 101} {
+		block := bc.GetBlockByHeight(height)
+		assert.Nil(t, walk(block), height)
+		_, err := block.accState.Accounts()
+		assert.Nil(t, err)
+	}
+
+	// historical states are readable until pruned.
+	_, err = bc.StateAt(bc.GetBlockByHeight(2))
+	assert.Equal(t, err, ErrStatePruned)
+	accState, err := bc.StateAt(bc.GetBlockByHeight(16))
+	assert.Nil(t, err)
+	assert.Equal(t, accState.GetOrCreateUserAccount(from.Bytes()).Balance(), bc.GetBlockByHeight(16).GetBalance(from.Bytes()))
+}
+
+func TestBlockChain_StatePruningInBackground(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	assert.Nil(t, bc.EnableStatePruning(32, 16))
+	pruner := bc.StatePruner()
+
+	// the tail moves on while the rounds are collected, run with -race.
+	mineTransferBlocks(t, bc, 100, func() {})
+	pruner.wait()
+	assert.Nil(t, pruner.Prune(bc.TailBlock()))
+	pruner.wait()
+	assert.True(t, pruner.PrunedHeight() >= 32)
+
+	for height := pruner.PrunedHeight() + 1; height <= bc.TailBlock().Height(); height++ {
+		block := bc.GetBlockByHeight(height)
+		assert.Nil(t, walkBlockTries(bc.storage, block, func(hash byteutils.HexHash) bool { return true }), height)
+	}
 }
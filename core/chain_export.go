@@ -0,0 +1,150 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+// ExportBlocks write the canonical blocks in [from, to] to w, a block is written as
+// a 4 bytes big-endian length followed by the marshaled corepb.Block.
+// zero to means the tail block. It returns the number of exported blocks.
+func (bc *BlockChain) ExportBlocks(w io.Writer, from, to uint64) (uint64, error) {
+	if from == 0 {
+		from = 1
+	}
+	if to == 0 {
+		to = bc.tailBlock.Height()
+	}
+	if from > to || to > bc.tailBlock.Height() {
+		return 0, ErrInvalidBlockRange
+	}
+
+	var count uint64
+	for height := from; height <= to; height++ {
+		block := bc.GetBlockByHeight(height)
+		if block == nil {
+			return count, ErrMissingParentBlock
+		}
+		pbBlock, err := block.ToProto()
+		if err != nil {
+			return count, err
+		}
+		data, err := proto.Marshal(pbBlock)
+		if err != nil {
+			return count, err
+		}
+		if err := writeRecord(w, data); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// ImportBlocks read the blocks written by ExportBlocks from r, re-execute and verify them
+// as the blocks received by BlockPool, and set each one as the tail.
+// Blocks already on chain are skipped. It returns the number of imported blocks.
+func (bc *BlockChain) ImportBlocks(r io.Reader) (uint64, error) {
+	var count uint64
+	for {
+		data, err := readRecord(r)
+		if err == io.EOF {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		pbBlock := new(corepb.Block)
+		if err := proto.Unmarshal(data, pbBlock); err != nil {
+			return count, err
+		}
+		block := new(Block)
+		if err := block.FromProto(pbBlock); err != nil {
+			return count, err
+		}
+		if bc.GetBlock(block.Hash()) != nil {
+			continue
+		}
+		if err := bc.importBlock(block); err != nil {
+			log.WithFields(log.Fields{
+				"func":  "BlockChain.ImportBlocks",
+				"block": block,
+				"err":   err,
+			}).Error("failed to import block.")
+			return count, err
+		}
+		count++
+	}
+}
+
+func (bc *BlockChain) importBlock(block *Block) error {
+	if err := block.verifyHash(bc.chainID); err != nil {
+		return err
+	}
+	parent := bc.GetBlock(block.ParentHash())
+	if parent == nil {
+		return ErrMissingParentBlock
+	}
+	if !block.LinkParentBlock(parent) {
+		return ErrLinkParentBlock
+	}
+	if err := bc.ConsensusHandler().VerifyBlock(block, parent); err != nil {
+		return err
+	}
+	if err := block.Verify(bc.chainID); err != nil {
+		return err
+	}
+	if err := bc.PutVerifiedNewBlocks([]*Block{block}, []*Block{block}); err != nil {
+		return err
+	}
+	return bc.SetTailBlock(block)
+}
+
+// writeRecord write data as a 4 bytes big-endian length followed by the data.
+func writeRecord(w io.Writer, data []byte) error {
+	size := make([]byte, 4)
+	binary.BigEndian.PutUint32(size, uint32(len(data)))
+	if _, err := w.Write(size); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// readRecord read a record written by writeRecord, io.EOF is returned only at the end of r.
+func readRecord(r io.Reader) ([]byte, error) {
+	size := make([]byte, 4)
+	if _, err := io.ReadFull(r, size); err != nil {
+		return nil, err
+	}
+	data := make([]byte, binary.BigEndian.Uint32(size))
+	if _, err := io.ReadFull(r, data); err != nil {
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return data, nil
+}
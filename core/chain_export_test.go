@@ -0,0 +1,69 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockChain_ExportAndImportBlocks(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+	for i := 0; i < 5; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(block))
+		assert.Nil(t, bc.SetTailBlock(block))
+	}
+
+	var buf bytes.Buffer
+	_, err := bc.ExportBlocks(&buf, 3, 100)
+	assert.Equal(t, err, ErrInvalidBlockRange)
+	count, err := bc.ExportBlocks(&buf, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, count, uint64(6))
+
+	other, _ := NewBlockChain(testNeb())
+	other.SetConsensusHandler(c)
+	exported := buf.Bytes()
+	count, err = other.ImportBlocks(bytes.NewReader(exported))
+	assert.Nil(t, err)
+	assert.Equal(t, count, uint64(5))
+	assert.Equal(t, other.TailBlock().Hash(), bc.TailBlock().Hash())
+	assert.Equal(t, other.TailBlock().StateRoot(), bc.TailBlock().StateRoot())
+
+	// blocks already on chain are skipped.
+	count, err = other.ImportBlocks(bytes.NewReader(exported))
+	assert.Nil(t, err)
+	assert.Equal(t, count, uint64(0))
+
+	// a truncated file fails.
+	third, _ := NewBlockChain(testNeb())
+	third.SetConsensusHandler(c)
+	_, err = third.ImportBlocks(bytes.NewReader(exported[:len(exported)-1]))
+	assert.NotNil(t, err)
+}
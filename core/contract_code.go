@@ -0,0 +1,83 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// ContractCode the code of a contract, stored in storage by the hash of its bytes like trie nodes,
+// so it is synced and verified with the states referring to it.
+type ContractCode struct {
+	SourceType string
+	Source     string
+}
+
+// CodeHash return the hash of the contract code.
+func CodeHash(sourceType, source string) byteutils.Hash {
+	code := &ContractCode{SourceType: sourceType, Source: source}
+	return hash.Sha3256(code.ToBytes())
+}
+
+// ToBytes encode the code as the length of source type, the source type and the source.
+func (code *ContractCode) ToBytes() []byte {
+	bytes := byteutils.FromUint32(uint32(len(code.SourceType)))
+	bytes = append(bytes, code.SourceType...)
+	return append(bytes, code.Source...)
+}
+
+// FromBytes decode the code encoded by ToBytes.
+func (code *ContractCode) FromBytes(bytes []byte) error {
+	if len(bytes) < 4 {
+		return ErrInvalidContractCode
+	}
+	size := byteutils.Uint32(bytes[:4])
+	if uint64(size) > uint64(len(bytes)-4) {
+		return ErrInvalidContractCode
+	}
+	code.SourceType = string(bytes[4 : 4+size])
+	code.Source = string(bytes[4+size:])
+	return nil
+}
+
+func storeContractCode(s storage.Storage, code *ContractCode) (byteutils.Hash, error) {
+	bytes := code.ToBytes()
+	codeHash := byteutils.Hash(hash.Sha3256(bytes))
+	if err := s.Put(codeHash, bytes); err != nil {
+		return nil, err
+	}
+	return codeHash, nil
+}
+
+func loadContractCode(s storage.Storage, codeHash byteutils.Hash) (*ContractCode, error) {
+	bytes, err := s.Get(codeHash)
+	if err != nil {
+		return nil, err
+	}
+	if !codeHash.Equals(hash.Sha3256(bytes)) {
+		return nil, ErrInvalidContractCode
+	}
+	code := new(ContractCode)
+	if err := code.FromBytes(bytes); err != nil {
+		return nil, err
+	}
+	return code, nil
+}
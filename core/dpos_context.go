@@ -39,6 +39,7 @@ const (
 	DynastyInterval      = int64(60) // TODO(roy): 3600
 	DynastySize          = 6         // TODO(roy): 21
 	SafeSize             = DynastySize/3 + 1
+	ConsensusSize        = DynastySize*2/3 + 1
 )
 
 // DposContext carry context in dpos consensus
@@ -659,29 +660,30 @@ func GenesisDynastyContext(storage storage.Storage, conf *corepb.Genesis) (*Dyna
 	}, nil
 }
 
-// NextDynastyContext when some seconds elapsed
+// NextDynastyContext when some seconds elapsed, the changes are buffered in an overlay of block's storage
 func (block *Block) NextDynastyContext(elapsedSecond int64) (*DynastyContext, error) {
-	dynastyTrie, err := block.dposContext.dynastyTrie.Clone()
+	overlay := storage.NewOverlayStorage(block.storage)
+	dynastyTrie, err := trie.NewBatchTrie(block.dposContext.dynastyTrie.RootHash(), overlay)
 	if err != nil {
 		return nil, err
 	}
-	nextDynastyTrie, err := block.dposContext.nextDynastyTrie.Clone()
+	nextDynastyTrie, err := trie.NewBatchTrie(block.dposContext.nextDynastyTrie.RootHash(), overlay)
 	if err != nil {
 		return nil, err
 	}
-	delegateTrie, err := block.dposContext.delegateTrie.Clone()
+	delegateTrie, err := trie.NewBatchTrie(block.dposContext.delegateTrie.RootHash(), overlay)
 	if err != nil {
 		return nil, err
 	}
-	candidateTrie, err := block.dposContext.candidateTrie.Clone()
+	candidateTrie, err := trie.NewBatchTrie(block.dposContext.candidateTrie.RootHash(), overlay)
 	if err != nil {
 		return nil, err
 	}
-	voteTrie, err := block.dposContext.voteTrie.Clone()
+	voteTrie, err := trie.NewBatchTrie(block.dposContext.voteTrie.RootHash(), overlay)
 	if err != nil {
 		return nil, err
 	}
-	mintCntTrie, err := block.dposContext.mintCntTrie.Clone()
+	mintCntTrie, err := trie.NewBatchTrie(block.dposContext.mintCntTrie.RootHash(), overlay)
 	if err != nil {
 		return nil, err
 	}
@@ -695,7 +697,7 @@ func (block *Block) NextDynastyContext(elapsedSecond int64) (*DynastyContext, er
 		VoteTrie:        voteTrie,
 		MintCntTrie:     mintCntTrie,
 		Accounts:        block.accState,
-		Storage:         block.storage,
+		Storage:         overlay,
 	}
 
 	baseDynastyID := block.header.timestamp / DynastyInterval
@@ -35,6 +35,12 @@ const (
 	// TopicCallSmartContract the topic of call a smart contract.
 	TopicCallSmartContract = "chain.callSmartContract"
 
+	// TopicUpgradeSmartContract the topic of upgrade a smart contract.
+	TopicUpgradeSmartContract = "chain.upgradeSmartContract"
+
+	// TopicDestructSmartContract the topic of destruct a smart contract.
+	TopicDestructSmartContract = "chain.destructSmartContract"
+
 	// TopicDelegate the topic of delegate.
 	TopicDelegate = "chain.delegate"
 
@@ -49,6 +55,18 @@ const (
 
 	// TopicExecuteTxSuccess the topic of execute a transaction success.
 	TopicExecuteTxSuccess = "chain.executeTxSuccess"
+
+	// TopicNewTailBlock the topic of a new canonical tail block.
+	TopicNewTailBlock = "chain.newTailBlock"
+
+	// TopicChainReorg the topic of a chain reorganization.
+	TopicChainReorg = "chain.reorg"
+
+	// TopicRevertBlock the topic of a block reverted by a chain reorganization.
+	TopicRevertBlock = "chain.revertBlock"
+
+	// TopicReapplyBlock the topic of a block applied by a chain reorganization.
+	TopicReapplyBlock = "chain.reapplyBlock"
 )
 
 // Event event structure.
@@ -57,6 +75,26 @@ type Event struct {
 	Data  string
 }
 
+// ReorgEvent the data of the tail change events. Hash and Height are of the
+// reverted or reapplied block, or of the new tail.
+type ReorgEvent struct {
+	Hash           string `json:"hash"`
+	Height         uint64 `json:"height"`
+	OldTail        string `json:"old_tail"`
+	NewTail        string `json:"new_tail"`
+	CommonAncestor string `json:"common_ancestor"`
+	Depth          int64  `json:"depth"`
+}
+
+// ContractEvent the data of the contract upgrade and destruct events.
+type ContractEvent struct {
+	Address  string `json:"address"`
+	Owner    string `json:"owner"`
+	CodeHash string `json:"code_hash"`
+	Version  uint32 `json:"version"`
+	Refund   string `json:"refund,omitempty"`
+}
+
 // EventEmitter provide event functionality for Nebulas.
 type EventEmitter struct {
 	eventSubs *sync.Map
@@ -92,6 +130,17 @@ func (emitter *EventEmitter) Trigger(e *Event) {
 	emitter.eventCh <- e
 }
 
+// TryTrigger trigger event without blocking, return false if the event is dropped
+// because the emitter is busy.
+func (emitter *EventEmitter) TryTrigger(e *Event) bool {
+	select {
+	case emitter.eventCh <- e:
+		return true
+	default:
+		return false
+	}
+}
+
 // Register register event chan.
 func (emitter *EventEmitter) Register(topic string, ch chan *Event) error {
 
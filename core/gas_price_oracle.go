@@ -0,0 +1,131 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+const (
+	// GasPriceOracleBlocks is the default number of recent blocks sampled by the oracle.
+	GasPriceOracleBlocks = 20
+
+	// GasPriceOraclePercentile is the default percentile of the suggested gasPrice.
+	GasPriceOraclePercentile = 60
+
+	// percentiles of the low, median and high gasPrices.
+	lowPercentile    = 25
+	medianPercentile = 50
+	highPercentile   = 75
+)
+
+// GasPrices is the gasPrices suggested by the oracle.
+type GasPrices struct {
+	Suggested *util.Uint128
+	Low       *util.Uint128
+	Median    *util.Uint128
+	High      *util.Uint128
+}
+
+// GasPriceOracle suggests gasPrices by the percentiles of the gasPrices of txs in recent blocks,
+// the result is cached until the tail changes.
+type GasPriceOracle struct {
+	bc         *BlockChain
+	blocks     int
+	percentile int
+
+	mu         sync.Mutex
+	cachedTail byteutils.Hash
+	cached     *GasPrices
+}
+
+// NewGasPriceOracle create a new GasPriceOracle.
+func NewGasPriceOracle(bc *BlockChain) *GasPriceOracle {
+	return &GasPriceOracle{
+		bc:         bc,
+		blocks:     GasPriceOracleBlocks,
+		percentile: GasPriceOraclePercentile,
+	}
+}
+
+// SetConfig config the number of sampled blocks and the percentile of the suggested gasPrice,
+// 0 means the default.
+func (o *GasPriceOracle) SetConfig(blocks, percentile int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if blocks <= 0 {
+		blocks = GasPriceOracleBlocks
+	}
+	if percentile <= 0 || percentile > 100 {
+		percentile = GasPriceOraclePercentile
+	}
+	o.blocks = blocks
+	o.percentile = percentile
+	o.cachedTail = nil
+}
+
+// GasPrices return the gasPrices suggested on the tail block.
+func (o *GasPriceOracle) GasPrices() *GasPrices {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	tail := o.bc.TailBlock()
+	if o.cachedTail != nil && o.cachedTail.Equals(tail.Hash()) {
+		return o.cached
+	}
+
+	var prices []*util.Uint128
+	block := tail
+	for i := 0; i < o.blocks && block != nil && !CheckGenesisBlock(block); i++ {
+		for _, tx := range block.transactions {
+			prices = append(prices, tx.gasPrice)
+		}
+		block = o.bc.GetBlock(block.ParentHash())
+	}
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].Cmp(prices[j].Int) < 0
+	})
+
+	o.cached = &GasPrices{
+		Suggested: o.pricePercentile(prices, o.percentile),
+		Low:       o.pricePercentile(prices, lowPercentile),
+		Median:    o.pricePercentile(prices, medianPercentile),
+		High:      o.pricePercentile(prices, highPercentile),
+	}
+	o.cachedTail = tail.Hash()
+	return o.cached
+}
+
+// pricePercentile return the percentile of sorted prices, not lower than the lowest gasPrice accepted by tx pool.
+func (o *GasPriceOracle) pricePercentile(prices []*util.Uint128, percentile int) *util.Uint128 {
+	lowest := o.bc.txPool.gasPrice
+	if len(prices) == 0 {
+		return lowest
+	}
+	price := prices[(len(prices)-1)*percentile/100]
+	if price.Cmp(lowest.Int) < 0 {
+		return lowest
+	}
+	return price
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGasPriceOracle(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	oracle := bc.GasPriceOracle()
+
+	// no tx on chain, suggest the lowest gasPrice of tx pool.
+	assert.Equal(t, bc.GasPrice(), TransactionGasPrice)
+
+	price := func(n int64) *util.Uint128 {
+		return util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(n).Int))
+	}
+	var prices []*util.Uint128
+	for i := int64(1); i <= 10; i++ {
+		prices = append(prices, price(i))
+	}
+	assert.Equal(t, oracle.pricePercentile(prices, 50), price(5))
+	assert.Equal(t, oracle.pricePercentile(prices, 25), price(3))
+	assert.Equal(t, oracle.pricePercentile(prices, 100), price(10))
+	cheap := []*util.Uint128{util.NewUint128FromInt(1)}
+	assert.Equal(t, oracle.pricePercentile(cheap, 50), TransactionGasPrice)
+
+	// the prices of txs in recent blocks are sampled.
+	addrs, newTx := mockPoolTxs(t, 1)
+	block0, _ := bc.NewBlock(addrs[0])
+	block0.header.timestamp = BlockInterval
+	block0.SetMiner(addrs[0])
+	assert.Nil(t, block0.Seal())
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block0)))
+	assert.Nil(t, bc.SetTailBlock(block0))
+
+	assert.Nil(t, bc.txPool.Push(newTx(0, 1, price(3))))
+	block1, _ := bc.NewBlock(addrs[0])
+	block1.header.timestamp = BlockInterval * 2
+	block1.CollectTransactions(1)
+	block1.SetMiner(addrs[0])
+	assert.Nil(t, block1.Seal())
+	assert.Equal(t, len(block1.transactions), 1)
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block1)))
+	assert.Nil(t, bc.SetTailBlock(block1))
+
+	prices2 := oracle.GasPrices()
+	assert.Equal(t, prices2.Suggested, price(3))
+	assert.Equal(t, prices2.Low, price(3))
+	assert.Equal(t, prices2.High, price(3))
+	assert.True(t, oracle.cachedTail.Equals(block1.Hash()))
+	assert.Equal(t, oracle.GasPrices(), prices2)
+}
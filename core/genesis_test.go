@@ -80,7 +80,9 @@ func TestNewGenesisBlock(t *testing.T) {
 	assert.Nil(t, err)
 	chain := &BlockChain{storage: storage}
 	genesis, err := NewGenesisBlock(conf, chain)
-	assert.Nil(t, chain.storeBlockToStorage(genesis))
+	batch := storage.NewBatch()
+	assert.Nil(t, chain.storeBlockToStorage(batch, genesis))
+	assert.Nil(t, batch.Write())
 	assert.Nil(t, err)
 
 	iter, err := genesis.dposContext.dynastyTrie.Iterator(nil)
@@ -16,7 +16,11 @@ It has these top-level messages:
 	Block
 	NetBlocks
 	NetBlock
+	PivotRequest
+	TrieNodesRequest
+	TrieNodes
 	DownloadBlock
+	TransactionIndex
 */
 package corepb
 
@@ -36,11 +40,15 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type Account struct {
-	Address    []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Balance    []byte `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
-	Nonce      uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	VarsHash   []byte `protobuf:"bytes,4,opt,name=vars_hash,json=varsHash,proto3" json:"vars_hash,omitempty"`
-	BirthPlace []byte `protobuf:"bytes,5,opt,name=birth_place,json=birthPlace,proto3" json:"birth_place,omitempty"`
+	Address     []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance     []byte `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Nonce       uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	VarsHash    []byte `protobuf:"bytes,4,opt,name=vars_hash,json=varsHash,proto3" json:"vars_hash,omitempty"`
+	BirthPlace  []byte `protobuf:"bytes,5,opt,name=birth_place,json=birthPlace,proto3" json:"birth_place,omitempty"`
+	CodeHash    []byte `protobuf:"bytes,6,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	CodeVersion uint32 `protobuf:"varint,7,opt,name=code_version,json=codeVersion,proto3" json:"code_version,omitempty"`
+	Destroyed   bool   `protobuf:"varint,8,opt,name=destroyed,proto3" json:"destroyed,omitempty"`
+	Owner       []byte `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
 func (m *Account) Reset()                    { *m = Account{} }
@@ -83,6 +91,34 @@ func (m *Account) GetBirthPlace() []byte {
 	return nil
 }
 
+func (m *Account) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+func (m *Account) GetCodeVersion() uint32 {
+	if m != nil {
+		return m.CodeVersion
+	}
+	return 0
+}
+
+func (m *Account) GetDestroyed() bool {
+	if m != nil {
+		return m.Destroyed
+	}
+	return false
+}
+
+func (m *Account) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
 type Data struct {
 	Type    string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
@@ -467,6 +503,94 @@ func (m *NetBlock) GetBlock() *Block {
 	return nil
 }
 
+type PivotRequest struct {
+	From  string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Batch uint64 `protobuf:"varint,2,opt,name=batch,proto3" json:"batch,omitempty"`
+}
+
+func (m *PivotRequest) Reset()                    { *m = PivotRequest{} }
+func (m *PivotRequest) String() string            { return proto.CompactTextString(m) }
+func (*PivotRequest) ProtoMessage()               {}
+func (*PivotRequest) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{8} }
+
+func (m *PivotRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *PivotRequest) GetBatch() uint64 {
+	if m != nil {
+		return m.Batch
+	}
+	return 0
+}
+
+type TrieNodesRequest struct {
+	From   string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Batch  uint64   `protobuf:"varint,2,opt,name=batch,proto3" json:"batch,omitempty"`
+	Hashes [][]byte `protobuf:"bytes,3,rep,name=hashes" json:"hashes,omitempty"`
+}
+
+func (m *TrieNodesRequest) Reset()                    { *m = TrieNodesRequest{} }
+func (m *TrieNodesRequest) String() string            { return proto.CompactTextString(m) }
+func (*TrieNodesRequest) ProtoMessage()               {}
+func (*TrieNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{9} }
+
+func (m *TrieNodesRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TrieNodesRequest) GetBatch() uint64 {
+	if m != nil {
+		return m.Batch
+	}
+	return 0
+}
+
+func (m *TrieNodesRequest) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+type TrieNodes struct {
+	From  string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Batch uint64   `protobuf:"varint,2,opt,name=batch,proto3" json:"batch,omitempty"`
+	Nodes [][]byte `protobuf:"bytes,3,rep,name=nodes" json:"nodes,omitempty"`
+}
+
+func (m *TrieNodes) Reset()                    { *m = TrieNodes{} }
+func (m *TrieNodes) String() string            { return proto.CompactTextString(m) }
+func (*TrieNodes) ProtoMessage()               {}
+func (*TrieNodes) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{10} }
+
+func (m *TrieNodes) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TrieNodes) GetBatch() uint64 {
+	if m != nil {
+		return m.Batch
+	}
+	return 0
+}
+
+func (m *TrieNodes) GetNodes() [][]byte {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
 type DownloadBlock struct {
 	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Sign []byte `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"`
@@ -475,7 +599,7 @@ type DownloadBlock struct {
 func (m *DownloadBlock) Reset()                    { *m = DownloadBlock{} }
 func (m *DownloadBlock) String() string            { return proto.CompactTextString(m) }
 func (*DownloadBlock) ProtoMessage()               {}
-func (*DownloadBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{8} }
+func (*DownloadBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{11} }
 
 func (m *DownloadBlock) GetHash() []byte {
 	if m != nil {
@@ -491,6 +615,30 @@ func (m *DownloadBlock) GetSign() []byte {
 	return nil
 }
 
+type TransactionIndex struct {
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Index     uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *TransactionIndex) Reset()                    { *m = TransactionIndex{} }
+func (m *TransactionIndex) String() string            { return proto.CompactTextString(m) }
+func (*TransactionIndex) ProtoMessage()               {}
+func (*TransactionIndex) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{12} }
+
+func (m *TransactionIndex) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *TransactionIndex) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Account)(nil), "corepb.Account")
 	proto.RegisterType((*Data)(nil), "corepb.Data")
@@ -500,57 +648,68 @@ func init() {
 	proto.RegisterType((*Block)(nil), "corepb.Block")
 	proto.RegisterType((*NetBlocks)(nil), "corepb.NetBlocks")
 	proto.RegisterType((*NetBlock)(nil), "corepb.NetBlock")
+	proto.RegisterType((*PivotRequest)(nil), "corepb.PivotRequest")
+	proto.RegisterType((*TrieNodesRequest)(nil), "corepb.TrieNodesRequest")
+	proto.RegisterType((*TrieNodes)(nil), "corepb.TrieNodes")
 	proto.RegisterType((*DownloadBlock)(nil), "corepb.DownloadBlock")
+	proto.RegisterType((*TransactionIndex)(nil), "corepb.TransactionIndex")
 }
 
 func init() { proto.RegisterFile("block.proto", fileDescriptorBlock) }
 
 var fileDescriptorBlock = []byte{
-	// 722 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0xd3, 0x4a,
-	0x10, 0x96, 0x93, 0x38, 0x3f, 0x63, 0xa7, 0xe7, 0x9c, 0x3d, 0x15, 0x72, 0xf9, 0x51, 0x83, 0xab,
-	0x4a, 0x11, 0x48, 0xbd, 0x28, 0x88, 0x5e, 0x43, 0x73, 0x51, 0x24, 0x84, 0x2a, 0x8b, 0x1b, 0x24,
-	0x24, 0x6b, 0x63, 0x2f, 0x89, 0x85, 0xb3, 0x6b, 0x79, 0xa7, 0x25, 0x79, 0x00, 0x1e, 0x80, 0xf7,
-	0xe0, 0xb9, 0x90, 0x78, 0x0b, 0xb4, 0xb3, 0x1b, 0xc7, 0xa1, 0xbd, 0xe1, 0x6e, 0xe7, 0xfb, 0x66,
-	0x77, 0x67, 0xbe, 0xf9, 0x76, 0x21, 0x98, 0x97, 0x2a, 0xfb, 0x72, 0x56, 0xd5, 0x0a, 0x15, 0xeb,
-	0x67, 0xaa, 0x16, 0xd5, 0x3c, 0xfe, 0xee, 0xc1, 0xe0, 0x75, 0x96, 0xa9, 0x1b, 0x89, 0x2c, 0x82,
-	0x01, 0xcf, 0xf3, 0x5a, 0x68, 0x1d, 0x79, 0x13, 0x6f, 0x1a, 0x26, 0xdb, 0xd0, 0x30, 0x73, 0x5e,
-	0x72, 0x99, 0x89, 0xa8, 0x63, 0x19, 0x17, 0xb2, 0x43, 0xf0, 0xa5, 0x32, 0x78, 0x77, 0xe2, 0x4d,
-	0x7b, 0x89, 0x0d, 0xd8, 0x23, 0x18, 0xdd, 0xf2, 0x5a, 0xa7, 0x4b, 0xae, 0x97, 0x51, 0x8f, 0x76,
-	0x0c, 0x0d, 0x70, 0xc5, 0xf5, 0x92, 0x1d, 0x43, 0x30, 0x2f, 0x6a, 0x5c, 0xa6, 0x55, 0xc9, 0x33,
-	0x11, 0xf9, 0x44, 0x03, 0x41, 0xd7, 0x06, 0x89, 0x5f, 0x42, 0x6f, 0xc6, 0x91, 0x33, 0x06, 0x3d,
-	0xdc, 0x54, 0x82, 0x8a, 0x19, 0x25, 0xb4, 0x36, 0x95, 0x54, 0x7c, 0x53, 0x2a, 0x9e, 0x6f, 0x2b,
-	0x71, 0x61, 0xfc, 0xa3, 0x03, 0xc1, 0x87, 0x9a, 0x4b, 0xcd, 0x33, 0x2c, 0x94, 0x34, 0xbb, 0xe9,
-	0x7a, 0xdb, 0x0a, 0xad, 0x0d, 0xf6, 0xb9, 0x56, 0x2b, 0xb7, 0x95, 0xd6, 0xec, 0x00, 0x3a, 0xa8,
-	0xa8, 0xfc, 0x30, 0xe9, 0xa0, 0x32, 0x1d, 0xdd, 0xf2, 0xf2, 0x46, 0xb8, 0xba, 0x6d, 0xb0, 0xeb,
-	0xd3, 0x6f, 0xf7, 0xf9, 0x18, 0x46, 0x58, 0xac, 0x84, 0x46, 0xbe, 0xaa, 0xa2, 0xfe, 0xc4, 0x9b,
-	0x76, 0x93, 0x1d, 0xc0, 0x26, 0xd0, 0xcb, 0x39, 0xf2, 0x68, 0x30, 0xf1, 0xa6, 0xc1, 0x79, 0x78,
-	0x66, 0x25, 0x3f, 0x33, 0xbd, 0x25, 0xc4, 0xb0, 0x23, 0x18, 0x66, 0x4b, 0x5e, 0xc8, 0xb4, 0xc8,
-	0xa3, 0xe1, 0xc4, 0x9b, 0x8e, 0x93, 0x01, 0xc5, 0x6f, 0x73, 0x23, 0xe1, 0x82, 0xeb, 0xb4, 0xaa,
-	0x8b, 0x4c, 0x44, 0x23, 0x2b, 0xe1, 0x82, 0xeb, 0x6b, 0x13, 0x6f, 0xc9, 0xb2, 0x58, 0x15, 0x18,
-	0x41, 0x43, 0xbe, 0x33, 0x31, 0xfb, 0x17, 0xba, 0xbc, 0x5c, 0x44, 0x01, 0x9d, 0x67, 0x96, 0xa6,
-	0x6d, 0x5d, 0x2c, 0x64, 0x14, 0xda, 0xb6, 0xcd, 0x3a, 0xfe, 0xe5, 0x41, 0x30, 0xab, 0x94, 0xbe,
-	0x54, 0x12, 0xc5, 0x1a, 0xd9, 0x53, 0x08, 0xf3, 0x8d, 0xe4, 0x1a, 0x37, 0x69, 0xad, 0x14, 0x3a,
-	0xd9, 0x02, 0x87, 0x25, 0x4a, 0x21, 0x7b, 0x06, 0xff, 0x49, 0xb1, 0xc6, 0x74, 0x2f, 0xcf, 0x4a,
-	0xf9, 0x8f, 0x21, 0x66, 0xad, 0xdc, 0x13, 0x18, 0xe7, 0xa2, 0x14, 0x0b, 0x8e, 0xc2, 0xe6, 0x59,
-	0x81, 0xc3, 0x2d, 0x48, 0x49, 0xa7, 0x70, 0x90, 0x71, 0x99, 0x17, 0x79, 0x93, 0x65, 0x35, 0x1f,
-	0x37, 0x28, 0xa5, 0x19, 0x37, 0xa9, 0x6d, 0x86, 0xef, 0xdc, 0xa4, 0x1c, 0x19, 0xc3, 0x78, 0x55,
-	0x48, 0x4c, 0x33, 0x89, 0x36, 0xa1, 0x6f, 0x0b, 0x37, 0xe0, 0xa5, 0x44, 0x93, 0x13, 0xff, 0xec,
-	0x40, 0xf0, 0xc6, 0x98, 0xff, 0x4a, 0xf0, 0x5c, 0xd4, 0xf7, 0x5a, 0xe3, 0x18, 0x82, 0x8a, 0xd7,
-	0x42, 0xa2, 0x35, 0xad, 0x6d, 0x0b, 0x2c, 0x44, 0xb6, 0xbd, 0xdf, 0xe9, 0x0f, 0x61, 0x98, 0xa9,
-	0x42, 0xce, 0xb9, 0xde, 0x1a, 0xa6, 0x89, 0xf7, 0xdd, 0xe1, 0xff, 0xe9, 0x8e, 0xf6, 0xec, 0xfb,
-	0xfb, 0xb3, 0x77, 0x13, 0x1c, 0xdc, 0x9d, 0xe0, 0x70, 0x37, 0x41, 0xf6, 0x04, 0x40, 0x63, 0xa3,
-	0x9c, 0xb5, 0xc8, 0x88, 0x10, 0x12, 0xe6, 0x08, 0x86, 0xb8, 0xd6, 0x96, 0xb4, 0x16, 0x19, 0xe0,
-	0x5a, 0x13, 0x75, 0x0c, 0x81, 0xb8, 0x15, 0x12, 0x1d, 0x1b, 0xd8, 0x5e, 0x2d, 0x44, 0x09, 0xaf,
-	0x20, 0xcc, 0x2b, 0xa5, 0xd3, 0xcc, 0x9a, 0x83, 0x8c, 0x13, 0x9c, 0xff, 0xdf, 0x38, 0x78, 0xe7,
-	0x9b, 0x24, 0xc8, 0x77, 0x41, 0xfc, 0xcd, 0x03, 0x9f, 0x84, 0x66, 0xcf, 0xa1, 0xbf, 0x24, 0xb1,
-	0x49, 0xe4, 0xd6, 0xde, 0xd6, 0x1c, 0x12, 0x97, 0xc2, 0x2e, 0x20, 0xc4, 0xdd, 0xcb, 0xd5, 0x51,
-	0x67, 0xd2, 0x6d, 0x6f, 0x69, 0xbd, 0xea, 0x64, 0x2f, 0x91, 0x3d, 0x30, 0xb7, 0x14, 0x8b, 0x25,
-	0xba, 0xa1, 0xb8, 0x28, 0xfe, 0x04, 0xa3, 0xf7, 0x02, 0xe9, 0x2a, 0xdd, 0x3c, 0x7a, 0xf7, 0x8d,
-	0xd0, 0xa3, 0x3f, 0x04, 0x7f, 0xce, 0x31, 0xb3, 0x73, 0xee, 0x25, 0x36, 0x60, 0xa7, 0xd0, 0xa7,
-	0x3f, 0x52, 0x47, 0x5d, 0xaa, 0x60, 0xbc, 0x57, 0x74, 0xe2, 0xc8, 0xf8, 0x23, 0x0c, 0xb7, 0xa7,
-	0xff, 0xc5, 0xe1, 0x27, 0xe0, 0xd3, 0x7e, 0x2a, 0xf5, 0xce, 0xd9, 0x96, 0x8b, 0x2f, 0x60, 0x3c,
-	0x53, 0x5f, 0xa5, 0xf9, 0xd0, 0x9a, 0xf3, 0xef, 0xfb, 0xc5, 0xc8, 0x0c, 0x9d, 0x9d, 0x19, 0xe6,
-	0x7d, 0xfa, 0xd6, 0x5f, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x27, 0xd4, 0x2d, 0xe5, 0x05,
-	0x00, 0x00,
+	// 844 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x8a, 0x23, 0x45,
+	0x14, 0x26, 0xff, 0xdd, 0xa7, 0x3b, 0xeb, 0x5a, 0x8a, 0xf4, 0xea, 0xca, 0xc4, 0x5e, 0x16, 0x06,
+	0x85, 0xb9, 0x58, 0xc5, 0xf5, 0x56, 0x37, 0xe0, 0x2e, 0xca, 0x32, 0x14, 0x83, 0x20, 0x08, 0x4d,
+	0xa5, 0xab, 0x4c, 0x1a, 0x3b, 0x55, 0x6d, 0xd7, 0x99, 0x6c, 0xf2, 0x00, 0xfb, 0x36, 0x3e, 0x97,
+	0xe0, 0x5b, 0x48, 0x9d, 0xaa, 0xee, 0x24, 0xce, 0x5c, 0x38, 0x77, 0xf5, 0x7d, 0xe7, 0x27, 0x75,
+	0xbe, 0xf3, 0x55, 0x07, 0x92, 0x55, 0x6d, 0xca, 0x3f, 0xae, 0x9a, 0xd6, 0xa0, 0x61, 0xd3, 0xd2,
+	0xb4, 0xaa, 0x59, 0xe5, 0xef, 0x87, 0x30, 0xfb, 0xbe, 0x2c, 0xcd, 0xad, 0x46, 0x96, 0xc1, 0x4c,
+	0x48, 0xd9, 0x2a, 0x6b, 0xb3, 0xc1, 0x62, 0x70, 0x99, 0xf2, 0x0e, 0xba, 0xc8, 0x4a, 0xd4, 0x42,
+	0x97, 0x2a, 0x1b, 0xfa, 0x48, 0x80, 0xec, 0x63, 0x98, 0x68, 0xe3, 0xf8, 0xd1, 0x62, 0x70, 0x39,
+	0xe6, 0x1e, 0xb0, 0xcf, 0x20, 0xde, 0x89, 0xd6, 0x16, 0x1b, 0x61, 0x37, 0xd9, 0x98, 0x2a, 0x22,
+	0x47, 0xbc, 0x16, 0x76, 0xc3, 0x2e, 0x20, 0x59, 0x55, 0x2d, 0x6e, 0x8a, 0xa6, 0x16, 0xa5, 0xca,
+	0x26, 0x14, 0x06, 0xa2, 0xae, 0x6b, 0xe1, 0xab, 0x4b, 0x23, 0x95, 0xaf, 0x9e, 0xfa, 0x6a, 0x47,
+	0x50, 0xf5, 0x17, 0x90, 0x52, 0x70, 0xa7, 0x5a, 0x5b, 0x19, 0x9d, 0xcd, 0x16, 0x83, 0xcb, 0x39,
+	0x4f, 0x1c, 0xf7, 0x8b, 0xa7, 0xd8, 0x53, 0x88, 0xa5, 0xb2, 0xd8, 0x9a, 0x83, 0x92, 0x59, 0xb4,
+	0x18, 0x5c, 0x46, 0xfc, 0x48, 0xb8, 0x1b, 0x9b, 0x77, 0x5a, 0xb5, 0x59, 0x4c, 0x9d, 0x3d, 0xc8,
+	0xbf, 0x81, 0xf1, 0x52, 0xa0, 0x60, 0x0c, 0xc6, 0x78, 0x68, 0x14, 0x09, 0x10, 0x73, 0x3a, 0xbb,
+	0xe9, 0x1b, 0x71, 0xa8, 0x8d, 0x90, 0xdd, 0xf4, 0x01, 0xe6, 0x7f, 0x0d, 0x21, 0xb9, 0x69, 0x85,
+	0xb6, 0xa2, 0x44, 0xf7, 0xcb, 0x0c, 0xc6, 0x74, 0x69, 0x2f, 0x1f, 0x9d, 0x1d, 0xf7, 0x7b, 0x6b,
+	0xb6, 0xa1, 0x94, 0xce, 0xec, 0x11, 0x0c, 0xd1, 0x90, 0x64, 0x29, 0x1f, 0xa2, 0x71, 0x77, 0xda,
+	0x89, 0xfa, 0x56, 0x05, 0xad, 0x3c, 0x38, 0x6a, 0x3b, 0x39, 0xd5, 0xf6, 0x29, 0xc4, 0x58, 0x6d,
+	0x95, 0x45, 0xb1, 0x6d, 0x48, 0x9d, 0x11, 0x3f, 0x12, 0x6c, 0x01, 0x63, 0x29, 0x50, 0x90, 0x2c,
+	0xc9, 0x8b, 0xf4, 0xca, 0xaf, 0xf9, 0xca, 0xcd, 0xc6, 0x29, 0xc2, 0x9e, 0x40, 0x54, 0x6e, 0x44,
+	0xa5, 0x8b, 0xca, 0x8b, 0x33, 0xe7, 0x33, 0xc2, 0x6f, 0xa4, 0x13, 0x7e, 0x2d, 0x6c, 0xd1, 0xb4,
+	0x55, 0xa9, 0x82, 0x3c, 0xd1, 0x5a, 0xd8, 0x6b, 0x87, 0xbb, 0x60, 0x5d, 0x6d, 0x2b, 0xcc, 0xa0,
+	0x0f, 0xfe, 0xec, 0x30, 0x7b, 0x0c, 0x23, 0x51, 0xaf, 0xb3, 0x84, 0xfa, 0xb9, 0xa3, 0x1b, 0xdb,
+	0x56, 0x6b, 0x9d, 0xa5, 0x7e, 0x6c, 0x77, 0xce, 0xff, 0x19, 0x40, 0xb2, 0x6c, 0x8c, 0x7d, 0x65,
+	0x34, 0xaa, 0x3d, 0xba, 0x5d, 0xca, 0x83, 0x16, 0x16, 0x0f, 0x45, 0x6b, 0x0c, 0x06, 0xd9, 0x92,
+	0xc0, 0x71, 0x63, 0x90, 0x7d, 0x09, 0x1f, 0x6a, 0xb5, 0xc7, 0xe2, 0x2c, 0xcf, 0x4b, 0xf9, 0x81,
+	0x0b, 0x2c, 0x4f, 0x72, 0x9f, 0xc1, 0x5c, 0xaa, 0x5a, 0xad, 0x05, 0x2a, 0x9f, 0xe7, 0x05, 0x4e,
+	0x3b, 0x92, 0x92, 0x9e, 0xc3, 0xa3, 0x52, 0x68, 0x59, 0xc9, 0x3e, 0xcb, 0x6b, 0x3e, 0xef, 0x59,
+	0x4a, 0x73, 0x0e, 0x36, 0x5d, 0xc6, 0x24, 0x38, 0xd8, 0x84, 0x60, 0x0e, 0xf3, 0x6d, 0xa5, 0xb1,
+	0x28, 0x35, 0xfa, 0x04, 0x6f, 0xd2, 0xc4, 0x91, 0xaf, 0x34, 0xba, 0x9c, 0xfc, 0xef, 0x21, 0x24,
+	0x3f, 0xb8, 0x07, 0xf7, 0x5a, 0x09, 0xa9, 0xda, 0x7b, 0xad, 0x71, 0x01, 0x49, 0x23, 0x5a, 0xa5,
+	0xd1, 0x5b, 0xdd, 0x8f, 0x05, 0x9e, 0x22, 0xb3, 0xdf, 0xff, 0xba, 0x3e, 0x85, 0xa8, 0x34, 0x95,
+	0x5e, 0x09, 0xdb, 0x19, 0xa6, 0xc7, 0xe7, 0xee, 0x98, 0xfc, 0xd7, 0x1d, 0xa7, 0xbb, 0x9f, 0x9e,
+	0xef, 0x3e, 0x6c, 0x70, 0x76, 0x77, 0x83, 0xd1, 0x71, 0x83, 0xec, 0x73, 0x00, 0x8b, 0xbd, 0x72,
+	0xde, 0x22, 0x31, 0x31, 0x24, 0xcc, 0x13, 0x88, 0x70, 0x6f, 0x7d, 0xd0, 0x5b, 0x64, 0x86, 0x7b,
+	0x4b, 0xa1, 0x0b, 0x48, 0xd4, 0x4e, 0x69, 0x0c, 0xd1, 0xc4, 0xcf, 0xea, 0x29, 0x4a, 0xf8, 0x16,
+	0x52, 0xd9, 0x18, 0x5b, 0x94, 0xde, 0x1c, 0x64, 0x9c, 0xe4, 0xc5, 0x47, 0xbd, 0x83, 0x8f, 0xbe,
+	0xe1, 0x89, 0x3c, 0x82, 0xfc, 0xfd, 0x00, 0x26, 0x24, 0x34, 0xfb, 0x0a, 0xa6, 0x1b, 0x12, 0x3b,
+	0x1b, 0x9c, 0xd7, 0x9e, 0xec, 0x81, 0x87, 0x14, 0xf6, 0x12, 0x52, 0x3c, 0xbe, 0x5c, 0x9b, 0x0d,
+	0x17, 0xa3, 0xd3, 0x92, 0x93, 0x57, 0xcd, 0xcf, 0x12, 0xd9, 0x27, 0xee, 0x57, 0xaa, 0xf5, 0x06,
+	0xc3, 0x52, 0x02, 0xca, 0x7f, 0x83, 0xf8, 0xad, 0x42, 0xfa, 0x29, 0xdb, 0x3f, 0xfa, 0xf0, 0x19,
+	0x71, 0x67, 0xb7, 0xcc, 0x95, 0xc0, 0xd2, 0xef, 0x79, 0xcc, 0x3d, 0x60, 0xcf, 0x61, 0x4a, 0xdf,
+	0x65, 0x9b, 0x8d, 0xe8, 0x06, 0xf3, 0xb3, 0x4b, 0xf3, 0x10, 0xcc, 0x7f, 0x85, 0xa8, 0xeb, 0xfe,
+	0x80, 0xe6, 0xcf, 0x60, 0x42, 0xf5, 0x74, 0xd5, 0x3b, 0xbd, 0x7d, 0x2c, 0xff, 0x0e, 0xd2, 0xeb,
+	0x6a, 0x67, 0x90, 0xab, 0x3f, 0x6f, 0x95, 0xc5, 0xff, 0xdf, 0x3e, 0xbf, 0x81, 0xc7, 0x37, 0x6d,
+	0xa5, 0xde, 0x1a, 0xa9, 0xec, 0x83, 0xab, 0x49, 0x48, 0x61, 0x37, 0xca, 0x4f, 0x9e, 0xf2, 0x80,
+	0xf2, 0x9f, 0x20, 0xee, 0xbb, 0x3e, 0xa0, 0x1d, 0xbd, 0x15, 0xd9, 0x77, 0xf3, 0x20, 0x7f, 0x09,
+	0xf3, 0xa5, 0x79, 0xa7, 0xdd, 0xd7, 0xba, 0x17, 0xef, 0xbe, 0x4f, 0x34, 0x39, 0x7d, 0x78, 0xf2,
+	0xad, 0xfa, 0xd1, 0xcd, 0xd6, 0xaf, 0xfd, 0x8d, 0x96, 0x6a, 0xef, 0xdc, 0x4f, 0x92, 0x15, 0x27,
+	0x1d, 0x62, 0x62, 0xba, 0xd7, 0x5a, 0xb9, 0xbc, 0xee, 0x5e, 0x04, 0x56, 0x53, 0xfa, 0xc3, 0xfd,
+	0xfa, 0xdf, 0x01, 0x00, 0x59, 0xb4, 0x93, 0x3e, 0x7f, 0x07, 0x00, 0x00,
 }
@@ -0,0 +1,80 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/common/trie/pb"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// ProveAccount return the account in the state trie of block and its merkle proof,
+// each element of the proof is a marshaled trie node from the root to the leaf.
+func (block *Block) ProveAccount(addr byteutils.Hash) ([]byte, [][]byte, error) {
+	return proveKey(block, block.StateRoot(), addr)
+}
+
+// ProveStorage return the account of contract and its merkle proof in the state trie of block,
+// and the value of key in the contract storage trie and its merkle proof.
+func (block *Block) ProveStorage(addr byteutils.Hash, key []byte) ([]byte, [][]byte, []byte, [][]byte, error) {
+	account, accountProof, err := block.ProveAccount(addr)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	pbAcc := new(corepb.Account)
+	if err := proto.Unmarshal(account, pbAcc); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	value, storageProof, err := proveKey(block, pbAcc.VarsHash, key)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return account, accountProof, value, storageProof, nil
+}
+
+// ProveTransaction return the transaction in the txs trie of block and its merkle proof.
+func (block *Block) ProveTransaction(hash byteutils.Hash) ([]byte, [][]byte, error) {
+	return proveKey(block, block.TxsRoot(), hash)
+}
+
+func proveKey(block *Block, root byteutils.Hash, key []byte) ([]byte, [][]byte, error) {
+	if len(root) == 0 {
+		return nil, nil, trie.ErrNotFound
+	}
+	t, err := trie.NewTrie(root, block.storage)
+	if err != nil {
+		return nil, nil, err
+	}
+	merkleProof, err := t.Prove(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	var proof [][]byte
+	for _, val := range merkleProof {
+		ir, err := proto.Marshal(&triepb.Node{Val: val})
+		if err != nil {
+			return nil, nil, err
+		}
+		proof = append(proof, ir)
+	}
+	// the last node in proof is the leaf holding the value.
+	return merkleProof[len(merkleProof)-1][2], proof, nil
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/light"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlock_ProveAccount(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+	block, _ := bc.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval
+	block.CollectTransactions(0)
+	block.SetMiner(coinbase)
+	assert.Nil(t, block.Seal())
+
+	account, proof, err := block.ProveAccount(coinbase.Bytes())
+	assert.Nil(t, err)
+	assert.NotEmpty(t, proof)
+
+	pbAcc, err := light.VerifyAccount(block.StateRoot(), coinbase.Bytes(), proof)
+	assert.Nil(t, err)
+	balance, err := block.GetBalance(coinbase.Bytes()).ToFixedSizeByteSlice()
+	assert.Nil(t, err)
+	assert.Equal(t, balance, pbAcc.Balance)
+
+	value, err := light.VerifyProof(block.StateRoot(), coinbase.Bytes(), proof)
+	assert.Nil(t, err)
+	assert.Equal(t, account, value)
+
+	_, _, err = block.ProveTransaction([]byte("012345678901234567890000"))
+	assert.Equal(t, err, trie.ErrNotFound)
+}
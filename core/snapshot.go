@@ -0,0 +1,244 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// snapshotBatchSize number of trie nodes written to storage at once when importing snapshot
+	snapshotBatchSize = 1024
+)
+
+// ExportSnapshot write the state of block to w, including the account state trie,
+// the contract storage tries and codes, the dpos tries and the txs & events tries.
+// The snapshot is the marshaled corepb.Block followed by the trie nodes and codes, each as
+// a record of ExportBlocks. It returns the number of exported nodes.
+func (bc *BlockChain) ExportSnapshot(w io.Writer, block *Block) (uint64, error) {
+	if bc.statePruner != nil && bc.statePruner.IsPruned(block.Height()) {
+		return 0, ErrStatePruned
+	}
+	pbBlock, err := block.ToProto()
+	if err != nil {
+		return 0, err
+	}
+	data, err := proto.Marshal(pbBlock)
+	if err != nil {
+		return 0, err
+	}
+	if err := writeRecord(w, data); err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	var writeErr error
+	visited := make(map[byteutils.HexHash]bool)
+	err = walkBlockTries(bc.storage, block, func(h byteutils.HexHash) bool {
+		if visited[h] || writeErr != nil {
+			return false
+		}
+		visited[h] = true
+		key, err := h.Hash()
+		if err != nil {
+			writeErr = err
+			return false
+		}
+		value, err := bc.storage.Get(key)
+		if err != nil {
+			writeErr = err
+			return false
+		}
+		if writeErr = writeRecord(w, value); writeErr != nil {
+			return false
+		}
+		count++
+		return true
+	})
+	if err != nil {
+		return count, err
+	}
+	return count, writeErr
+}
+
+// ImportSnapshot read a snapshot written by ExportSnapshot from r, check all the tries
+// of the block are complete, then set the block as the tail.
+// It only works on a chain with the genesis block alone.
+func (bc *BlockChain) ImportSnapshot(r io.Reader) (*Block, error) {
+	if !CheckGenesisBlock(bc.tailBlock) {
+		return nil, ErrSnapshotOnNonEmptyChain
+	}
+
+	data, err := readRecord(r)
+	if err != nil {
+		return nil, err
+	}
+	pbBlock := new(corepb.Block)
+	if err := proto.Unmarshal(data, pbBlock); err != nil {
+		return nil, err
+	}
+	block := new(Block)
+	if err := block.FromProto(pbBlock); err != nil {
+		return nil, err
+	}
+	if err := block.verifyHash(bc.chainID); err != nil {
+		return nil, err
+	}
+
+	// nodes and codes are stored by their hashes, a forged one is never reachable from the roots.
+	var count uint64
+	batch := bc.storage.NewBatch()
+	for {
+		data, err := readRecord(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := batch.Put(hash.Sha3256(data), data); err != nil {
+			return nil, err
+		}
+		count++
+		if count%snapshotBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return nil, err
+			}
+			batch = bc.storage.NewBatch()
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+
+	tail, err := bc.SetSnapshotTail(block)
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithFields(log.Fields{
+		"func":  "BlockChain.ImportSnapshot",
+		"block": tail,
+		"nodes": count,
+	}).Info("Imported snapshot.")
+	return tail, nil
+}
+
+// SetSnapshotTail set the block as the tail when all of its tries are synced in storage.
+// The snapshot is not irreversible, the lib is left to the consensus.
+// It only works on a chain with the genesis block alone.
+func (bc *BlockChain) SetSnapshotTail(block *Block) (*Block, error) {
+	if !CheckGenesisBlock(bc.tailBlock) {
+		return nil, ErrSnapshotOnNonEmptyChain
+	}
+	if err := block.verifyHash(bc.chainID); err != nil {
+		return nil, err
+	}
+	if err := bc.verifySnapshot(block); err != nil {
+		return nil, err
+	}
+
+	batch := bc.storage.NewBatch()
+	if err := bc.storeBlockToStorage(batch, block); err != nil {
+		return nil, err
+	}
+	if err := batch.Put(heightIndexKey(block.Height()), block.Hash()); err != nil {
+		return nil, err
+	}
+	if err := bc.storeTxIndexToStorage(batch, block); err != nil {
+		return nil, err
+	}
+	// the blocks below the snapshot have no states.
+	prunedHeight := block.Height() - 1
+	if err := batch.Put([]byte(PrunedHeight), byteutils.FromUint64(prunedHeight)); err != nil {
+		return nil, err
+	}
+	if err := bc.storeTailToStorage(batch, block); err != nil {
+		return nil, err
+	}
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+
+	tail, err := LoadBlockFromStorage(block.Hash(), bc.storage, bc.txPool, bc.eventEmitter)
+	if err != nil {
+		return nil, err
+	}
+	bc.cachedBlocks.ContainsOrAdd(tail.Hash().Hex(), tail)
+	bc.tailBlock = tail
+	if bc.statePruner != nil {
+		bc.statePruner.setPrunedHeight(prunedHeight)
+	}
+	return tail, nil
+}
+
+// verifySnapshot check all the tries and contract codes of block are complete in storage.
+func (bc *BlockChain) verifySnapshot(block *Block) error {
+	roots := blockTrieRoots(block)
+	for i, root := range roots {
+		t, err := trie.NewTrie(nil, bc.storage)
+		if err != nil {
+			return err
+		}
+		if err := t.SyncTrie(root); err != nil {
+			return err
+		}
+		if i > 0 {
+			continue
+		}
+		// the variables tries and codes of the accounts in state trie.
+		var varsRoots, codeHashes []byteutils.Hash
+		if err := t.Walk(func(hash []byte, leafVal []byte) (bool, error) {
+			if leafVal == nil {
+				return true, nil
+			}
+			pbAcc := new(corepb.Account)
+			if err := proto.Unmarshal(leafVal, pbAcc); err != nil {
+				return false, err
+			}
+			if len(pbAcc.VarsHash) > 0 {
+				varsRoots = append(varsRoots, pbAcc.VarsHash)
+			}
+			if len(pbAcc.CodeHash) > 0 {
+				codeHashes = append(codeHashes, pbAcc.CodeHash)
+			}
+			return true, nil
+		}); err != nil {
+			return err
+		}
+		for _, varsRoot := range varsRoots {
+			if err := t.SyncTrie(varsRoot); err != nil {
+				return err
+			}
+		}
+		for _, codeHash := range codeHashes {
+			if _, err := loadContractCode(bc.storage, codeHash); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
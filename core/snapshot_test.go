@@ -0,0 +1,118 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockChain_Snapshot(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	coinbase, _ := NewAddressFromPublicKey(pubdata)
+	ks.SetKey(coinbase.String(), priv, []byte("passphrase"))
+	ks.Unlock(coinbase.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(coinbase.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	// a contract is deployed by the coinbase in the second block.
+	source := "var Contract = function() {}; Contract.prototype = {init: function() {}}; module.exports = Contract;"
+	payload, _ := NewDeployPayload(source, "js", "").ToBytes()
+	deployTx := NewTransaction(0, coinbase, coinbase, util.NewUint128(), 1, TxPayloadDeployType, payload, TransactionGasPrice, util.NewUint128FromInt(1000000))
+	deployTx.Sign(signature)
+	contractAddr, _ := deployTx.GenerateContractAddress()
+	for i := 0; i < 5; i++ {
+		if i == 1 {
+			assert.Nil(t, bc.txPool.Push(deployTx))
+		}
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(1)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(block))
+		assert.Nil(t, bc.SetTailBlock(block))
+	}
+
+	var buf bytes.Buffer
+	count, err := bc.ExportSnapshot(&buf, bc.TailBlock())
+	assert.Nil(t, err)
+	assert.True(t, count > 0)
+	snapshot := buf.Bytes()
+
+	other, _ := NewBlockChain(testNeb())
+	other.SetConsensusHandler(c)
+	tail, err := other.ImportSnapshot(bytes.NewReader(snapshot))
+	assert.Nil(t, err)
+	assert.Equal(t, tail.Hash(), bc.TailBlock().Hash())
+	assert.Equal(t, other.TailBlock().Hash(), bc.TailBlock().Hash())
+	assert.Equal(t, other.GetBlockByHeight(tail.Height()).Hash(), tail.Hash())
+	assert.Equal(t, other.TailBlock().GetBalance(coinbase.Bytes()), bc.TailBlock().GetBalance(coinbase.Bytes()))
+	// the code of the contract is in the snapshot.
+	contract, err := other.TailBlock().accState.GetContractAccount(contractAddr.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, CodeHash("js", source), contract.CodeHash())
+	contractSource, err := other.TailBlock().GetContractSource(contract)
+	assert.Nil(t, err)
+	assert.Equal(t, source, contractSource.Source)
+	// the snapshot is not marked irreversible.
+	assert.True(t, CheckGenesisBlock(other.LIB()))
+
+	// the chain grows on the snapshot.
+	block, _ := other.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval * 6
+	block.CollectTransactions(0)
+	block.SetMiner(coinbase)
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, other.BlockPool().Push(block))
+	assert.Nil(t, other.SetTailBlock(block))
+	assert.Equal(t, other.TailBlock().Height(), tail.Height()+1)
+
+	_, err = other.ImportSnapshot(bytes.NewReader(snapshot))
+	assert.Equal(t, err, ErrSnapshotOnNonEmptyChain)
+
+	// a snapshot without trie nodes fails the verification.
+	third, _ := NewBlockChain(testNeb())
+	third.SetConsensusHandler(c)
+	blockOnly := snapshot[:4+binary.BigEndian.Uint32(snapshot[:4])]
+	_, err = third.ImportSnapshot(bytes.NewReader(blockOnly))
+	assert.Equal(t, err, storage.ErrKeyNotFound)
+	assert.True(t, CheckGenesisBlock(third.TailBlock()))
+
+	// a missing node fails the export.
+	assert.Nil(t, bc.storage.Del(bc.TailBlock().StateRoot()))
+	buf.Reset()
+	_, err = bc.ExportSnapshot(&buf, bc.TailBlock())
+	assert.Equal(t, err, storage.ErrKeyNotFound)
+}
@@ -35,8 +35,18 @@ import (
 var (
 	ErrBalanceInsufficient = errors.New("cannot subtract a value which is bigger than current balance")
 	ErrAccountNotFound     = errors.New("cannot found account in storage")
+	ErrContractDestroyed   = errors.New("contract has been destructed")
 )
 
+// CheckReceiver return an error if the account can't receive value, the balance
+// of a destructed contract has been refunded and nobody can withdraw it again.
+func CheckReceiver(acc Account) error {
+	if acc.Destroyed() {
+		return ErrContractDestroyed
+	}
+	return nil
+}
+
 // account info in state Trie
 type account struct {
 	address byteutils.Hash
@@ -47,6 +57,13 @@ type account struct {
 	variables *trie.BatchTrie
 	// ContractType: Transaction Hash
 	birthPlace byteutils.Hash
+	// ContractType: deployer of the contract
+	owner byteutils.Hash
+	// ContractType: hash of the contract code in storage, nil for contracts
+	// deployed before codes are stored by hash
+	codeHash    byteutils.Hash
+	codeVersion uint32
+	destroyed   bool
 }
 
 // ToBytes converts domain Account to bytes
@@ -56,11 +73,15 @@ func (acc *account) ToBytes() ([]byte, error) {
 		return nil, err
 	}
 	pbAcc := &corepb.Account{
-		Address:    acc.address,
-		Balance:    value,
-		Nonce:      acc.nonce,
-		VarsHash:   acc.variables.RootHash(),
-		BirthPlace: acc.birthPlace,
+		Address:     acc.address,
+		Balance:     value,
+		Nonce:       acc.nonce,
+		VarsHash:    acc.variables.RootHash(),
+		BirthPlace:  acc.birthPlace,
+		CodeHash:    acc.codeHash,
+		CodeVersion: acc.codeVersion,
+		Destroyed:   acc.destroyed,
+		Owner:       acc.owner,
 	}
 	bytes, err := proto.Marshal(pbAcc)
 	if err != nil {
@@ -83,6 +104,10 @@ func (acc *account) FromBytes(bytes []byte, storage storage.Storage) error {
 	acc.balance = value
 	acc.nonce = pbAcc.Nonce
 	acc.birthPlace = pbAcc.BirthPlace
+	acc.codeHash = pbAcc.CodeHash
+	acc.codeVersion = pbAcc.CodeVersion
+	acc.destroyed = pbAcc.Destroyed
+	acc.owner = pbAcc.Owner
 	acc.variables, err = trie.NewBatchTrie(pbAcc.VarsHash, storage)
 	if err != nil {
 		return err
@@ -115,6 +140,26 @@ func (acc *account) BirthPlace() byteutils.Hash {
 	return acc.birthPlace
 }
 
+// Owner return contract's owner
+func (acc *account) Owner() byteutils.Hash {
+	return acc.owner
+}
+
+// CodeHash return the hash of contract's code
+func (acc *account) CodeHash() byteutils.Hash {
+	return acc.codeHash
+}
+
+// CodeVersion return how many times contract's code has been upgraded
+func (acc *account) CodeVersion() uint32 {
+	return acc.codeVersion
+}
+
+// Destroyed return if the contract has destructed itself
+func (acc *account) Destroyed() bool {
+	return acc.destroyed
+}
+
 // BeginBatch begins a batch task
 func (acc *account) BeginBatch() {
 	log.Info("Account Begin.")
@@ -156,6 +201,17 @@ func (acc *account) SubBalance(value *util.Uint128) error {
 	return nil
 }
 
+// UpgradeCode replace contract's code with codeHash and bump its version
+func (acc *account) UpgradeCode(codeHash byteutils.Hash) {
+	acc.codeHash = codeHash
+	acc.codeVersion++
+}
+
+// Destroy mark the contract as destructed
+func (acc *account) Destroy() {
+	acc.destroyed = true
+}
+
 // Put into account's storage
 func (acc *account) Put(key []byte, value []byte) error {
 	_, err := acc.variables.Put(key, value)
@@ -181,13 +237,17 @@ func (acc *account) Iterator(prefix []byte) (Iterator, error) {
 }
 
 func (acc *account) String() string {
-	return fmt.Sprintf("Account %p {Address: %v, Balance:%v; Nonce:%v; VarsHash:%v; BirthPlace:%v}",
+	return fmt.Sprintf("Account %p {Address: %v, Balance:%v; Nonce:%v; VarsHash:%v; BirthPlace:%v; Owner:%v; CodeHash:%v; CodeVersion:%v; Destroyed:%v}",
 		acc,
 		byteutils.Hex(acc.address),
 		acc.balance.Int,
 		acc.nonce,
 		byteutils.Hex(acc.variables.RootHash()),
 		acc.birthPlace.Hex(),
+		acc.owner.Hex(),
+		acc.codeHash.Hex(),
+		acc.codeVersion,
+		acc.destroyed,
 	)
 }
 
@@ -220,7 +280,7 @@ func (as *accountState) recordDirtyAccount(addr byteutils.Hash, acc Account) {
 	}
 }
 
-func (as *accountState) newAccount(addr byteutils.Hash, birthPlace byteutils.Hash) Account {
+func (as *accountState) newAccount(addr byteutils.Hash, birthPlace, owner, codeHash byteutils.Hash) Account {
 	varTrie, _ := trie.NewBatchTrie(nil, as.storage)
 	acc := &account{
 		address:    addr,
@@ -228,6 +288,8 @@ func (as *accountState) newAccount(addr byteutils.Hash, birthPlace byteutils.Has
 		nonce:      0,
 		variables:  varTrie,
 		birthPlace: birthPlace,
+		owner:      owner,
+		codeHash:   codeHash,
 	}
 	as.recordDirtyAccount(addr, acc)
 	return acc
@@ -266,7 +328,7 @@ func (as *accountState) RootHash() byteutils.Hash {
 func (as *accountState) GetOrCreateUserAccount(addr []byte) Account {
 	acc, err := as.getAccount(addr)
 	if err != nil {
-		acc := as.newAccount(addr, nil)
+		acc := as.newAccount(addr, nil, nil, nil)
 		return acc
 	}
 	return acc
@@ -281,12 +343,19 @@ func (as *accountState) GetContractAccount(addr []byte) (Account, error) {
 	return acc, nil
 }
 
-// CreateContractAccount according to the addr, and set birthPlace as creation tx hash
-func (as *accountState) CreateContractAccount(addr []byte, birthPlace []byte) (Account, error) {
-	acc := as.newAccount(addr, birthPlace)
+// CreateContractAccount according to the addr, and set birthPlace as creation tx hash,
+// owner as the deployer and codeHash as the key of its code in storage
+func (as *accountState) CreateContractAccount(addr []byte, birthPlace []byte, owner []byte, codeHash []byte) (Account, error) {
+	acc := as.newAccount(addr, birthPlace, owner, codeHash)
 	return acc, nil
 }
 
+// DiscardAccount drop the account created in current batch, which is not in the state before
+func (as *accountState) DiscardAccount(addr []byte) {
+	delete(as.dirtyAccount, byteutils.Hash(addr).Hex())
+	as.stateTrie.Del(addr)
+}
+
 func (as *accountState) Accounts() ([]Account, error) {
 	accounts := []Account{}
 	iter, err := as.stateTrie.Iterator(nil)
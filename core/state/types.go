@@ -37,6 +37,10 @@ type Account interface {
 	Nonce() uint64
 	BirthPlace() byteutils.Hash
 	VarsHash() byteutils.Hash
+	Owner() byteutils.Hash
+	CodeHash() byteutils.Hash
+	CodeVersion() uint32
+	Destroyed() bool
 
 	BeginBatch()
 	Commit()
@@ -48,6 +52,8 @@ type Account interface {
 	IncreNonce()
 	AddBalance(value *util.Uint128)
 	SubBalance(value *util.Uint128) error
+	UpgradeCode(codeHash byteutils.Hash)
+	Destroy()
 	Put(key []byte, value []byte) error
 	Get(key []byte) ([]byte, error)
 	Del(key []byte) error
@@ -67,5 +73,6 @@ type AccountState interface {
 
 	GetOrCreateUserAccount(addr []byte) Account
 	GetContractAccount(addr []byte) (Account, error)
-	CreateContractAccount(addr []byte, birthPlace []byte) (Account, error)
+	CreateContractAccount(addr []byte, birthPlace []byte, owner []byte, codeHash []byte) (Account, error)
+	DiscardAccount(addr []byte)
 }
@@ -0,0 +1,394 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultStatePruneKeepBlocks number of latest blocks whose states are kept by default
+	DefaultStatePruneKeepBlocks = 128
+
+	// DefaultStatePruneCheckpointInterval interval of checkpoint blocks whose states are kept by default
+	DefaultStatePruneCheckpointInterval = 1024
+
+	// statePruneInterval minimal number of blocks pruned in a round
+	statePruneInterval = 32
+)
+
+// StatePruner reclaims the trie nodes unreachable from the kept blocks.
+// The states of the latest canonical blocks, the checkpoints, the genesis and
+// the forks above the pruned height are kept. The root nodes of pruned tries are
+// kept too, so pruned blocks can still be loaded.
+// The nodes are collected in background, nodes found unreachable in a round are
+// deleted when the next round finishes if they are still unreachable, which
+// protects the nodes written by blocks in execution. The blocks of a round are
+// resolved before it starts, the background only walks their tries.
+type StatePruner struct {
+	bc                 *BlockChain
+	keepBlocks         uint64
+	checkpointInterval uint64
+
+	mu           sync.RWMutex
+	prunedHeight uint64
+
+	staleBlocks []*Block
+	garbage     map[byteutils.HexHash]bool
+	round       *pruneRound
+}
+
+// pruneRound collects in background the nodes only reachable from the blocks up to target.
+type pruneRound struct {
+	target      uint64
+	staleBlocks []*Block
+	kept        []*Block
+	pruned      []*Block
+	marked      map[byteutils.HexHash]bool
+	roots       map[byteutils.HexHash]bool
+	garbage     map[byteutils.HexHash]bool
+	err         error
+	done        chan struct{}
+}
+
+// NewStatePruner create a new StatePruner, zero values mean the defaults.
+func NewStatePruner(bc *BlockChain, keepBlocks, checkpointInterval uint64) (*StatePruner, error) {
+	if keepBlocks == 0 {
+		keepBlocks = DefaultStatePruneKeepBlocks
+	}
+	if keepBlocks < statePruneInterval {
+		keepBlocks = statePruneInterval
+	}
+	if checkpointInterval == 0 {
+		checkpointInterval = DefaultStatePruneCheckpointInterval
+	}
+	p := &StatePruner{
+		bc:                 bc,
+		keepBlocks:         keepBlocks,
+		checkpointInterval: checkpointInterval,
+		garbage:            make(map[byteutils.HexHash]bool),
+	}
+	value, err := bc.storage.Get([]byte(PrunedHeight))
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err == nil {
+		p.prunedHeight = byteutils.Uint64(value)
+	}
+	return p, nil
+}
+
+// PrunedHeight return the height at and below which the states of non-checkpoint blocks are pruned.
+func (p *StatePruner) PrunedHeight() uint64 {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.prunedHeight
+}
+
+func (p *StatePruner) setPrunedHeight(height uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.prunedHeight = height
+}
+
+// IsPruned return true if the state of the canonical block at given height is pruned.
+func (p *StatePruner) IsPruned(height uint64) bool {
+	return height > 1 && height <= p.PrunedHeight() && height%p.checkpointInterval != 0
+}
+
+// AddStaleBlock add a block reverted from canonical chain, pruned when it is below the kept blocks.
+func (p *StatePruner) AddStaleBlock(block *Block) {
+	p.staleBlocks = append(p.staleBlocks, block)
+}
+
+// Prune finish the round collected in background, and start a new round when the
+// tail grows enough since the last round. It is called with the new tail.
+func (p *StatePruner) Prune(tail *Block) error {
+	if round := p.round; round != nil {
+		select {
+		case <-round.done:
+		default:
+			return nil
+		}
+		p.round = nil
+		if err := p.finish(round, tail); err != nil {
+			return err
+		}
+	}
+
+	if tail.Height() <= p.keepBlocks {
+		return nil
+	}
+	target := tail.Height() - p.keepBlocks
+	if target < p.PrunedHeight()+statePruneInterval {
+		return nil
+	}
+	kept, err := p.keptBlocks(tail, target)
+	if err != nil {
+		return err
+	}
+	round := &pruneRound{
+		target: target,
+		kept:   kept,
+		done:   make(chan struct{}),
+	}
+	for height := p.PrunedHeight() + 1; height <= target; height++ {
+		if height == 1 || height%p.checkpointInterval == 0 {
+			continue
+		}
+		block := p.bc.GetBlockByHeight(height)
+		if block == nil {
+			return ErrMissingParentBlock
+		}
+		round.pruned = append(round.pruned, block)
+	}
+	var staleBlocks []*Block
+	for _, block := range p.staleBlocks {
+		if block.Height() <= target {
+			round.staleBlocks = append(round.staleBlocks, block)
+		} else {
+			staleBlocks = append(staleBlocks, block)
+		}
+	}
+	round.pruned = append(round.pruned, round.staleBlocks...)
+	p.staleBlocks = staleBlocks
+	p.round = round
+	go p.collect(round)
+	return nil
+}
+
+// wait for the running round to be collected.
+func (p *StatePruner) wait() {
+	if p.round != nil {
+		<-p.round.done
+	}
+}
+
+// collect mark the nodes reachable from the kept blocks, and collect the nodes
+// only reachable from the blocks pruned in the round.
+func (p *StatePruner) collect(round *pruneRound) {
+	defer close(round.done)
+
+	round.marked = make(map[byteutils.HexHash]bool)
+	if round.err = p.mark(round.marked, round.kept); round.err != nil {
+		return
+	}
+
+	round.roots = make(map[byteutils.HexHash]bool)
+	for _, block := range round.pruned {
+		for _, root := range blockTrieRoots(block) {
+			round.roots[root.Hex()] = true
+		}
+	}
+	round.garbage = make(map[byteutils.HexHash]bool)
+	for _, block := range round.pruned {
+		if round.err = walkBlockTries(p.bc.storage, block, func(hash byteutils.HexHash) bool {
+			if round.marked[hash] || round.garbage[hash] {
+				return false
+			}
+			if !round.roots[hash] {
+				round.garbage[hash] = true
+			}
+			return true
+		}); round.err != nil {
+			return
+		}
+	}
+}
+
+// finish delete the nodes collected in last round which are still unreachable.
+func (p *StatePruner) finish(round *pruneRound, tail *Block) error {
+	if round.err != nil {
+		p.staleBlocks = append(p.staleBlocks, round.staleBlocks...)
+		return round.err
+	}
+
+	// the blocks linked since the round started are marked too, the nodes
+	// marked in the round are skipped.
+	kept, err := p.keptBlocks(tail, round.target)
+	if err == nil {
+		err = p.mark(round.marked, kept)
+	}
+	if err != nil {
+		p.staleBlocks = append(p.staleBlocks, round.staleBlocks...)
+		return err
+	}
+	for hash := range round.garbage {
+		if round.marked[hash] {
+			delete(round.garbage, hash)
+		}
+	}
+
+	batch := p.bc.storage.NewBatch()
+	deleted := 0
+	for hash := range p.garbage {
+		if round.marked[hash] || round.roots[hash] {
+			continue
+		}
+		key, err := hash.Hash()
+		if err != nil {
+			return err
+		}
+		if err := batch.Del(key); err != nil {
+			return err
+		}
+		deleted++
+	}
+	if err := batch.Put([]byte(PrunedHeight), byteutils.FromUint64(round.target)); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"func":         "StatePruner.Prune",
+		"prunedHeight": round.target,
+		"blocks":       len(round.pruned),
+		"deleted":      deleted,
+		"pending":      len(round.garbage),
+	}).Info("Pruned stale states.")
+
+	p.setPrunedHeight(round.target)
+	p.garbage = round.garbage
+	return nil
+}
+
+// keptBlocks return the blocks whose states are kept when pruning up to target,
+// it reads the chain so it is called on the goroutine updating the tail.
+func (p *StatePruner) keptBlocks(tail *Block, target uint64) ([]*Block, error) {
+	kept := []*Block{p.bc.genesisBlock}
+	for block := tail; block.Height() > target; {
+		kept = append(kept, block)
+		if block = p.bc.GetBlock(block.ParentHash()); block == nil {
+			return nil, ErrMissingParentBlock
+		}
+	}
+	for height := p.checkpointInterval; height <= target; height += p.checkpointInterval {
+		// checkpoints below an imported snapshot have no state.
+		if block := p.bc.GetBlockByHeight(height); block != nil && p.hasState(block) {
+			kept = append(kept, block)
+		}
+	}
+	// forks above the pruned height may still become canonical.
+	for _, block := range p.bc.DetachedTailBlocks() {
+		for block != nil && block.Height() > target {
+			canonical := p.bc.GetBlockByHeight(block.Height())
+			if canonical != nil && canonical.Hash().Equals(block.Hash()) {
+				break
+			}
+			kept = append(kept, block)
+			block = p.bc.GetBlock(block.ParentHash())
+		}
+	}
+	return kept, nil
+}
+
+// mark add the nodes reachable from the kept blocks to marked.
+func (p *StatePruner) mark(marked map[byteutils.HexHash]bool, kept []*Block) error {
+	visit := func(hash byteutils.HexHash) bool {
+		if marked[hash] {
+			return false
+		}
+		marked[hash] = true
+		return true
+	}
+	for _, block := range kept {
+		if err := walkBlockTries(p.bc.storage, block, visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *StatePruner) hasState(block *Block) bool {
+	if len(block.StateRoot()) == 0 {
+		return true
+	}
+	_, err := p.bc.storage.Get(block.StateRoot())
+	return err == nil
+}
+
+// walkBlockTries walk the nodes of all tries in block and the codes of the contracts in state,
+// the sub-trie of a node is skipped when visit returns false.
+func walkBlockTries(s storage.Storage, block *Block, visit func(hash byteutils.HexHash) bool) error {
+	roots := blockTrieRoots(block)
+	if err := walkTrie(s, roots[0], true, visit); err != nil {
+		return err
+	}
+	for _, root := range roots[1:] {
+		if err := walkTrie(s, root, false, visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkTrie walk the nodes in trie, and the variables tries and codes of accounts if it is a state trie.
+// It fails on the nodes missing in storage.
+func walkTrie(s storage.Storage, root byteutils.Hash, isState bool, visit func(hash byteutils.HexHash) bool) error {
+	if len(root) == 0 {
+		return nil
+	}
+	t, err := trie.NewTrie(root, s)
+	if err != nil {
+		return err
+	}
+	return t.Walk(func(hash []byte, leafVal []byte) (bool, error) {
+		if !visit(byteutils.Hash(hash).Hex()) {
+			return false, nil
+		}
+		if isState && leafVal != nil {
+			pbAcc := new(corepb.Account)
+			if err := proto.Unmarshal(leafVal, pbAcc); err != nil {
+				return false, err
+			}
+			if err := walkTrie(s, pbAcc.VarsHash, false, visit); err != nil {
+				return false, err
+			}
+			if len(pbAcc.CodeHash) > 0 {
+				visit(byteutils.Hash(pbAcc.CodeHash).Hex())
+			}
+		}
+		return true, nil
+	})
+}
+
+// blockTrieRoots return the roots of tries in block, the state root is the first.
+func blockTrieRoots(block *Block) []byteutils.Hash {
+	dposContext := block.DposContext()
+	return []byteutils.Hash{
+		block.StateRoot(),
+		block.TxsRoot(),
+		block.EventsRoot(),
+		dposContext.DynastyRoot,
+		dposContext.NextDynastyRoot,
+		dposContext.DelegateRoot,
+		dposContext.CandidateRoot,
+		dposContext.VoteRoot,
+		dposContext.MintCntRoot,
+	}
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/state"
 	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/hash"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
@@ -54,10 +55,36 @@ var (
 	// TransactionDataGas per byte of data attached to a transaction gas cost
 	TransactionDataGas = util.NewUint128FromInt(1)
 
+	// ContractCodeGas per byte of contract source prepared and stored by an upgrade gas cost
+	ContractCodeGas = util.NewUint128FromInt(1)
+
 	executeTxCounter    = metrics.GetOrRegisterCounter("tx_execute", nil)
 	executeTxErrCounter = metrics.GetOrRegisterCounter("tx_execute_err", nil)
 )
 
+const (
+	// TxExecutionFailed status of a failed transaction execution
+	TxExecutionFailed = 0
+
+	// TxExecutionSuccess status of a successful transaction execution
+	TxExecutionSuccess = 1
+
+	// MaxExecutionResultLength the max length of the result recorded in events trie,
+	// the full result is only returned from simulations.
+	MaxExecutionResultLength = 256
+)
+
+// TransactionEvent the execution result of a transaction, recorded in events trie.
+// It replaces the json of tx recorded by older versions, so the events root of
+// blocks with txs differs, and chains created by them must be rebuilt from genesis.
+type TransactionEvent struct {
+	Hash    string `json:"hash"`
+	Status  int8   `json:"status"`
+	GasUsed string `json:"gas_used"`
+	Error   string `json:"error"`
+	Result  string `json:"execute_result"`
+}
+
 // Transaction type is used to handle all transaction data.
 type Transaction struct {
 	hash      byteutils.Hash
@@ -271,7 +298,9 @@ func (tx *Transaction) Execute(block *Block) (*util.Uint128, error) {
 		fromAcc.SubBalance(util.NewUint128FromBigInt(gas))
 		coinbaseAcc.AddBalance(util.NewUint128FromBigInt(gas))
 
-		tx.triggerEvent(TopicExecuteTxFailed, block)
+		if err := tx.recordResultEvent(block, tx.gasLimit, "", ErrOutofGasLimit); err != nil {
+			return nil, err
+		}
 		return tx.gasLimit, nil
 	}
 
@@ -291,6 +320,10 @@ func (tx *Transaction) Execute(block *Block) (*util.Uint128, error) {
 		payload, err = LoadCandidatePayload(tx.data.Payload)
 	case TxPayloadDelegateType:
 		payload, err = LoadDelegatePayload(tx.data.Payload)
+	case TxPayloadUpgradeType:
+		payload, err = LoadUpgradePayload(tx.data.Payload)
+	case TxPayloadDestructType:
+		payload, err = LoadDestructPayload(tx.data.Payload)
 	default:
 		return nil, ErrInvalidTxPayloadType
 	}
@@ -298,17 +331,27 @@ func (tx *Transaction) Execute(block *Block) (*util.Uint128, error) {
 		return nil, err
 	}
 
-	// execute smart contract and sub the calcute gas.
-	gasExecution, err := payload.Execute(tx, block)
-	if err != nil {
+	// execute smart contract and sub the calcute gas, the value of tx can't be
+	// credited to a destructed contract.
+	var (
+		gasExecution *util.Uint128
+		result       string
+	)
+	exeErr := state.CheckReceiver(toAcc)
+	if exeErr == nil {
+		gasExecution, result, exeErr = payload.Execute(tx, block)
+	}
+	if gasExecution == nil {
+		gasExecution = util.NewUint128()
+	}
+	if exeErr != nil {
 		log.WithFields(log.Fields{
-			"error":       ErrOutofGasLimit,
+			"error":       exeErr,
 			"block":       block,
 			"transaction": tx,
 		}).Error("Transaction Execute.")
 
 		executeTxErrCounter.Inc(1)
-		tx.triggerEvent(TopicExecuteTxFailed, block)
 	} else {
 		// accept the transaction
 		fromAcc.SubBalance(tx.value)
@@ -331,16 +374,37 @@ func (tx *Transaction) Execute(block *Block) (*util.Uint128, error) {
 	fromAcc.SubBalance(util.NewUint128FromBigInt(gasCost))
 	coinbaseAcc.AddBalance(util.NewUint128FromBigInt(gasCost))
 
-	// record tx execution success event
-	tx.triggerEvent(TopicExecuteTxSuccess, block)
+	// record tx execution result event
+	if err := tx.recordResultEvent(block, gas, result, exeErr); err != nil {
+		return nil, err
+	}
 	return gas, nil
 }
 
-func (tx *Transaction) triggerEvent(topic string, block *Block) {
-	txData, _ := json.Marshal(tx)
+// recordResultEvent record the execution result of tx in block's events trie.
+func (tx *Transaction) recordResultEvent(block *Block, gasUsed *util.Uint128, result string, exeErr error) error {
+	if len(result) > MaxExecutionResultLength {
+		result = result[:MaxExecutionResultLength]
+	}
+	topic := TopicExecuteTxSuccess
+	txEvent := &TransactionEvent{
+		Hash:    tx.hash.String(),
+		Status:  TxExecutionSuccess,
+		GasUsed: gasUsed.String(),
+		Result:  result,
+	}
+	if exeErr != nil {
+		topic = TopicExecuteTxFailed
+		txEvent.Status = TxExecutionFailed
+		txEvent.Error = exeErr.Error()
+	}
+	txData, err := json.Marshal(txEvent)
+	if err != nil {
+		return err
+	}
 	event := &Event{Topic: topic,
 		Data: string(txData)}
-	block.recordEvent(tx.hash, event)
+	return block.recordEvent(tx.hash, event)
 }
 
 // Sign sign transaction,sign algorithm is
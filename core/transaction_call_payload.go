@@ -53,11 +53,11 @@ func (payload *CallPayload) ToBytes() ([]byte, error) {
 	return json.Marshal(payload)
 }
 
-// Execute the call payload in tx, call a function
-func (payload *CallPayload) Execute(tx *Transaction, block *Block) (*util.Uint128, error) {
-	ctx, deployPayload, err := generateCallContext(tx, block)
+// Execute the call payload in tx, call a function and return its json-serialized result
+func (payload *CallPayload) Execute(tx *Transaction, block *Block) (*util.Uint128, string, error) {
+	ctx, source, err := generateCallContext(tx, block)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	engine := nvm.NewV8Engine(ctx)
@@ -68,14 +68,14 @@ func (payload *CallPayload) Execute(tx *Transaction, block *Block) (*util.Uint12
 	executionInstructions.Sub(tx.gasLimit.Int, tx.CalculateGas().Int)
 	engine.SetExecutionLimits(executionInstructions.Uint64(), nvm.DefaultLimitsOfTotalMemorySize)
 
-	err = engine.Call(deployPayload.Source, deployPayload.SourceType, payload.Function, payload.Args)
+	result, err := engine.CallContract(source, payload.Function, payload.Args)
 	if err == nil {
 		block.accState = ctx.State()
 	}
-	return util.NewUint128FromInt(int64(engine.ExecutionInstructions())), nil
+	return util.NewUint128FromInt(int64(engine.ExecutionInstructions())), result, err
 }
 
-func generateCallContext(tx *Transaction, block *Block) (*nvm.Context, *DeployPayload, error) {
+func generateCallContext(tx *Transaction, block *Block) (*nvm.Context, *nvm.ContractSource, error) {
 	context, err := block.accState.Clone()
 	if err != nil {
 		return nil, nil, err
@@ -84,16 +84,12 @@ func generateCallContext(tx *Transaction, block *Block) (*nvm.Context, *DeployPa
 	if err != nil {
 		return nil, nil, err
 	}
-	birthTx, err := block.GetTransaction(contract.BirthPlace())
-	if err != nil {
-		return nil, nil, err
-	}
-	owner := context.GetOrCreateUserAccount(birthTx.from.Bytes())
-	deploy, err := LoadDeployPayload(birthTx.data.Payload)
+	source, err := block.GetContractSource(contract)
 	if err != nil {
 		return nil, nil, err
 	}
+	owner := context.GetOrCreateUserAccount(source.Owner)
 
 	ctx := nvm.NewContext(block, convertNvmTx(tx), owner, contract, context)
-	return ctx, deploy, nil
+	return ctx, source, nil
 }
@@ -58,14 +58,14 @@ func (payload *CandidatePayload) ToBytes() ([]byte, error) {
 }
 
 // Execute the candidate payload in tx
-func (payload *CandidatePayload) Execute(tx *Transaction, block *Block) (*util.Uint128, error) {
+func (payload *CandidatePayload) Execute(tx *Transaction, block *Block) (*util.Uint128, string, error) {
 	candidate := tx.from.Bytes()
 	counter := util.NewUint128()
 	switch payload.Action {
 	case LoginAction:
 		counter.Add(counter.Int, one.Int)
 		if _, err := block.dposContext.candidateTrie.Put(candidate, candidate); err != nil {
-			return counter, err
+			return counter, "", err
 		}
 		log.WithFields(log.Fields{
 			"func":      "Payload.Candidate",
@@ -76,7 +76,7 @@ func (payload *CandidatePayload) Execute(tx *Transaction, block *Block) (*util.U
 	case LogoutAction:
 		counter.Add(counter.Int, one.Int)
 		if err := block.kickoutCandidate(candidate); err != nil {
-			return counter, err
+			return counter, "", err
 		}
 		log.WithFields(log.Fields{
 			"func":      "Payload.Candidate",
@@ -85,7 +85,7 @@ func (payload *CandidatePayload) Execute(tx *Transaction, block *Block) (*util.U
 			"candidate": tx.from.String(),
 		}).Info("Candidate Logout.")
 	default:
-		return counter, ErrInvalidCandidatePayloadAction
+		return counter, "", ErrInvalidCandidatePayloadAction
 	}
-	return counter, nil
+	return counter, "", nil
 }
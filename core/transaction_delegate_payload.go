@@ -64,26 +64,26 @@ func (payload *DelegatePayload) ToBytes() ([]byte, error) {
 }
 
 // Execute the call payload in tx, call a function
-func (payload *DelegatePayload) Execute(tx *Transaction, block *Block) (*util.Uint128, error) {
+func (payload *DelegatePayload) Execute(tx *Transaction, block *Block) (*util.Uint128, string, error) {
 	delegator := tx.from.Bytes()
 	counter := util.NewUint128()
 	delegatee, err := AddressParse(payload.Delegatee)
 	if err != nil {
-		return counter, err
+		return counter, "", err
 	}
 	counter.Add(counter.Int, one.Int)
 	// check delegatee valid
 	_, err = block.dposContext.candidateTrie.Get(delegatee.Bytes())
 	if err != nil && err != storage.ErrKeyNotFound {
-		return counter, err
+		return counter, "", err
 	}
 	if err == storage.ErrKeyNotFound {
-		return counter, ErrInvalidDelegateToNonCandidate
+		return counter, "", ErrInvalidDelegateToNonCandidate
 	}
 	counter.Add(counter.Int, one.Int)
 	pre, err := block.dposContext.voteTrie.Get(delegator)
 	if err != nil && err != storage.ErrKeyNotFound {
-		return counter, err
+		return counter, "", err
 	}
 	switch payload.Action {
 	case DelegateAction:
@@ -91,33 +91,33 @@ func (payload *DelegatePayload) Execute(tx *Transaction, block *Block) (*util.Ui
 			key := append(pre, delegator...)
 			counter.Add(counter.Int, one.Int)
 			if _, err = block.dposContext.delegateTrie.Del(key); err != nil {
-				return counter, err
+				return counter, "", err
 			}
 		}
 		key := append(delegatee.Bytes(), delegator...)
 		counter.Add(counter.Int, one.Int)
 		if _, err = block.dposContext.delegateTrie.Put(key, delegator); err != nil {
-			return counter, err
+			return counter, "", err
 		}
 		counter.Add(counter.Int, one.Int)
 		if _, err = block.dposContext.voteTrie.Put(delegator, delegatee.Bytes()); err != nil {
-			return counter, err
+			return counter, "", err
 		}
 	case UnDelegateAction:
 		if !delegatee.address.Equals(pre) {
-			return counter, ErrInvalidUnDelegateFromNonDelegatee
+			return counter, "", ErrInvalidUnDelegateFromNonDelegatee
 		}
 		key := append(delegatee.Bytes(), delegator...)
 		counter.Add(counter.Int, one.Int)
 		if _, err = block.dposContext.delegateTrie.Del(key); err != nil {
-			return counter, err
+			return counter, "", err
 		}
 		counter.Add(counter.Int, one.Int)
 		if _, err = block.dposContext.voteTrie.Del(delegator); err != nil {
-			return counter, err
+			return counter, "", err
 		}
 	default:
-		return counter, ErrInvalidDelegatePayloadAction
+		return counter, "", ErrInvalidDelegatePayloadAction
 	}
-	return counter, nil
+	return counter, "", nil
 }
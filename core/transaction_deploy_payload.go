@@ -23,6 +23,7 @@ import (
 
 	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 )
 
 // DeployPayload carry contract deploy information
@@ -55,11 +56,11 @@ func (payload *DeployPayload) ToBytes() ([]byte, error) {
 	return json.Marshal(payload)
 }
 
-// Execute deploy payload in tx, deploy a new contract
-func (payload *DeployPayload) Execute(tx *Transaction, block *Block) (*util.Uint128, error) {
-	ctx, err := generateDeployContext(tx, block)
+// Execute deploy payload in tx, deploy a new contract and return the result of init
+func (payload *DeployPayload) Execute(tx *Transaction, block *Block) (*util.Uint128, string, error) {
+	ctx, err := generateDeployContext(tx, block, CodeHash(payload.SourceType, payload.Source))
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	engine := nvm.NewV8Engine(ctx)
@@ -69,15 +70,22 @@ func (payload *DeployPayload) Execute(tx *Transaction, block *Block) (*util.Uint
 	executionInstructions.Sub(tx.gasLimit.Int, tx.CalculateGas().Int)
 	engine.SetExecutionLimits(executionInstructions.Uint64(), nvm.DefaultLimitsOfTotalMemorySize)
 
-	// Deploy and Init.
-	err = engine.DeployAndInit(payload.Source, payload.SourceType, payload.Args)
+	// Deploy and Init, the source is stored for later calls.
+	traceable, lineOffset, err := engine.PrepareContractScript(payload.Source, payload.SourceType)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	result, err := engine.RunPreparedContractScript(traceable, lineOffset, "init", payload.Args)
+	if err == nil {
+		_, err = storeContractCode(block.storage, &ContractCode{SourceType: payload.SourceType, Source: payload.Source})
+	}
 	if err == nil {
 		block.accState = ctx.State()
 	}
-	return util.NewUint128FromInt(int64(engine.ExecutionInstructions())), nil
+	return util.NewUint128FromInt(int64(engine.ExecutionInstructions())), result, err
 }
 
-func generateDeployContext(tx *Transaction, block *Block) (*nvm.Context, error) {
+func generateDeployContext(tx *Transaction, block *Block, codeHash byteutils.Hash) (*nvm.Context, error) {
 	addr, err := tx.GenerateContractAddress()
 	if err != nil {
 		return nil, err
@@ -87,7 +95,7 @@ func generateDeployContext(tx *Transaction, block *Block) (*nvm.Context, error)
 		return nil, err
 	}
 	owner := context.GetOrCreateUserAccount(tx.from.Bytes())
-	contract, err := context.CreateContractAccount(addr.Bytes(), tx.Hash())
+	contract, err := context.CreateContractAccount(addr.Bytes(), tx.Hash(), tx.from.Bytes(), codeHash)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,78 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// DestructPayload carry a contract self-destruct
+type DestructPayload struct {
+}
+
+// LoadDestructPayload from bytes
+func LoadDestructPayload(bytes []byte) (*DestructPayload, error) {
+	payload := &DestructPayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewDestructPayload return a destruct payload
+func NewDestructPayload() *DestructPayload {
+	return &DestructPayload{}
+}
+
+// ToBytes serialize payload
+func (payload *DestructPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// Execute the destruct payload in tx, refund the balance of tx.to to its owner and refuse further calls
+func (payload *DestructPayload) Execute(tx *Transaction, block *Block) (*util.Uint128, string, error) {
+	if tx.value.Sign() != 0 {
+		return nil, "", ErrDestructWithValue
+	}
+	contract, err := loadOwnedContract(tx, block)
+	if err != nil {
+		return nil, "", err
+	}
+	refund := util.NewUint128()
+	refund.Add(refund.Int, contract.Balance().Int)
+
+	if err := recordContractEvent(tx, block, TopicDestructSmartContract, &ContractEvent{
+		Address:  tx.to.String(),
+		Owner:    tx.from.String(),
+		CodeHash: contract.CodeHash().String(),
+		Version:  contract.CodeVersion(),
+		Refund:   refund.String(),
+	}); err != nil {
+		return nil, "", err
+	}
+	if err := contract.SubBalance(refund); err != nil {
+		return nil, "", err
+	}
+	owner := block.accState.GetOrCreateUserAccount(tx.from.Bytes())
+	owner.AddBalance(refund)
+	contract.Destroy()
+	return util.NewUint128(), "", nil
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	log "github.com/sirupsen/logrus"
+)
+
+// TransactionJournal keeps the locally submitted txs in storage,
+// so they are replayed into the tx pool when the node restarts.
+type TransactionJournal struct {
+	storage storage.Storage
+}
+
+// NewTransactionJournal create a journal in storage.
+func NewTransactionJournal(storage storage.Storage) *TransactionJournal {
+	return &TransactionJournal{storage: storage}
+}
+
+func journalKey(hash byteutils.Hash) []byte {
+	return append([]byte(TxJournalPrefix), hash...)
+}
+
+func journalValue(tx *Transaction) ([]byte, error) {
+	pbTx, err := tx.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pbTx)
+}
+
+// Insert add tx into the journal.
+func (j *TransactionJournal) Insert(tx *Transaction) error {
+	data, err := journalValue(tx)
+	if err != nil {
+		return err
+	}
+	return j.storage.Put(journalKey(tx.hash), data)
+}
+
+// Load return all txs in the journal, the broken ones are skipped.
+func (j *TransactionJournal) Load() ([]*Transaction, error) {
+	iter := j.storage.NewIterator([]byte(TxJournalPrefix))
+	defer iter.Release()
+
+	var txs []*Transaction
+	for iter.Next() {
+		tx, err := parseJournalValue(iter.Value())
+		if err != nil {
+			log.WithFields(log.Fields{
+				"func": "TransactionJournal.Load",
+				"key":  byteutils.Hex(iter.Key()),
+				"err":  err,
+			}).Warn("skip broken transaction in journal.")
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	return txs, iter.Error()
+}
+
+func parseJournalValue(data []byte) (*Transaction, error) {
+	pbTx := new(corepb.Transaction)
+	if err := proto.Unmarshal(data, pbTx); err != nil {
+		return nil, err
+	}
+	tx := new(Transaction)
+	if err := tx.FromProto(pbTx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Rotate replace the txs in the journal with txs atomically, return the count of dropped txs.
+func (j *TransactionJournal) Rotate(txs []*Transaction) (int, error) {
+	keep := make(map[string]bool)
+	batch := j.storage.NewBatch()
+	for _, tx := range txs {
+		data, err := journalValue(tx)
+		if err != nil {
+			return 0, err
+		}
+		key := journalKey(tx.hash)
+		keep[string(key)] = true
+		if err := batch.Put(key, data); err != nil {
+			return 0, err
+		}
+	}
+
+	iter := j.storage.NewIterator([]byte(TxJournalPrefix))
+	defer iter.Release()
+	dropped := 0
+	for iter.Next() {
+		if keep[string(iter.Key())] {
+			continue
+		}
+		key := make([]byte, len(iter.Key()))
+		copy(key, iter.Key())
+		if err := batch.Del(key); err != nil {
+			return 0, err
+		}
+		dropped++
+	}
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+	return dropped, batch.Write()
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockNetManager struct{}
+
+func (m mockNetManager) Start() error                                         { return nil }
+func (m mockNetManager) Stop()                                                {}
+func (m mockNetManager) Register(subscribers ...*net.Subscriber)              {}
+func (m mockNetManager) Deregister(subscribers ...*net.Subscriber)            {}
+func (m mockNetManager) Broadcast(name string, msg net.Serializable)          {}
+func (m mockNetManager) Relay(name string, msg net.Serializable)              {}
+func (m mockNetManager) SendMsg(name string, msg []byte, target string) error { return nil }
+
+func TestTransactionPool_Journal(t *testing.T) {
+	neb := testNeb()
+	bc, _ := NewBlockChain(neb)
+	bc.txPool.nm = mockNetManager{}
+	assert.Nil(t, bc.txPool.EnableJournal(0))
+
+	_, newTx := mockPoolTxs(t, 2)
+	local1 := newTx(0, 1, TransactionGasPrice)
+	local2 := newTx(0, 2, TransactionGasPrice)
+	remote := newTx(1, 1, TransactionGasPrice)
+	assert.Nil(t, bc.txPool.PushAndBroadcast(local1))
+	assert.Nil(t, bc.txPool.PushAndBroadcast(local2))
+	assert.Nil(t, bc.txPool.Push(remote))
+	// a stale tx in journal is skipped.
+	assert.Nil(t, NewTransactionJournal(neb.storage).Insert(newTx(0, 0, TransactionGasPrice)))
+
+	// restart, only the local txs are replayed.
+	restarted, _ := NewBlockChain(neb)
+	pool := restarted.txPool
+	assert.Nil(t, pool.EnableJournal(0))
+	assert.Equal(t, len(pool.all), 2)
+	assert.Equal(t, pool.GetTransaction(local1.hash).Hash(), local1.hash)
+	assert.Equal(t, pool.GetTransaction(local2.hash).Hash(), local2.hash)
+	txs, err := pool.journal.Load()
+	assert.Nil(t, err)
+	assert.Equal(t, len(txs), 2)
+
+	// the txs left pool are dropped when the journal rotates.
+	assert.Equal(t, pool.Pop().Hash(), local1.hash)
+	dropped, err := pool.rotateJournal()
+	assert.Nil(t, err)
+	assert.Equal(t, dropped, 1)
+	txs, err = pool.journal.Load()
+	assert.Nil(t, err)
+	assert.Equal(t, len(txs), 1)
+	assert.Equal(t, txs[0].Hash(), local2.hash)
+}
@@ -19,7 +19,9 @@
 package core
 
 import (
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/common/pdeque"
@@ -36,37 +38,115 @@ var (
 	duplicateTxCounter     = metrics.GetOrRegisterCounter("txpool_duplicate", nil)
 	belowGasPriceTxCounter = metrics.GetOrRegisterCounter("txpool_below_gas_price", nil)
 	outOfGasLimitTxCounter = metrics.GetOrRegisterCounter("txpool_out_of_gas_limit", nil)
+	underpricedTxCounter   = metrics.GetOrRegisterCounter("txpool_underpriced_replacement", nil)
+	replacedTxCounter      = metrics.GetOrRegisterCounter("txpool_replaced", nil)
+	evictedTxCounter       = metrics.GetOrRegisterCounter("txpool_evicted", nil)
+	expiredTxCounter       = metrics.GetOrRegisterCounter("txpool_expired", nil)
 )
 
+const (
+	// TransactionPriceBump is the default percentage by which a tx must raise the gasPrice
+	// of the pooled tx with the same sender and nonce to replace it.
+	TransactionPriceBump = 10
+
+	// TransactionPoolSize is the default maximum count of txs in pool.
+	TransactionPoolSize = 4096
+
+	// TransactionPoolAccountSlots is the default count of txs a sender can keep in a full pool,
+	// the txs of senders over the quota are evicted first.
+	TransactionPoolAccountSlots = 64
+
+	// TransactionPoolLifetime is the default maximum time a tx stays in pool.
+	TransactionPoolLifetime = 3 * time.Hour
+
+	// TransactionJournalInterval is the default interval to rotate the journal of local txs.
+	TransactionJournalInterval = time.Hour
+
+	// expireInterval is the interval to drop the expired txs.
+	expireInterval = time.Minute
+)
+
+// TransactionPoolStatus is the status and limits of the pool.
+type TransactionPoolStatus struct {
+	Count        int
+	Pending      int
+	Queued       int
+	Accounts     int
+	Size         int
+	AccountSlots int
+	Lifetime     time.Duration
+}
+
 // TransactionPool cache txs, is thread safe
 type TransactionPool struct {
 	receivedMessageCh chan net.Message
 	quitCh            chan int
 
-	size  int
-	cache *pdeque.PriorityDeque
-	all   map[byteutils.HexHash]*Transaction
-	bc    *BlockChain
+	size         int
+	accountSlots int
+	lifetime     time.Duration
+	candidates   *pdeque.PriorityDeque
+	accounts     map[byteutils.HexHash]*accountTxs
+	all          map[byteutils.HexHash]*Transaction
+	arrivals     map[byteutils.HexHash]time.Time
+	bc           *BlockChain
+
+	journal         *TransactionJournal
+	journalInterval time.Duration
+	locals          map[byteutils.HexHash]bool // senders of the locally submitted txs.
 
 	nm net.Manager
 	mu sync.RWMutex
 
-	gasPrice *util.Uint128 // the lowest gasPrice.
-	gasLimt  *util.Uint128 // the maximum gasLimit.
+	gasPrice  *util.Uint128 // the lowest gasPrice.
+	gasLimt   *util.Uint128 // the maximum gasLimit.
+	priceBump uint32        // the percentage of gasPrice bump to replace a tx.
+}
+
+// accountTxs holds the pooled txs of one sender by nonce, the txs with
+// continuous nonces from next are pending, the others are queued.
+type accountTxs struct {
+	addr  byteutils.Hash
+	txs   map[uint64]*Transaction
+	nonce uint64 // the nonce of the sender in tail block.
+	next  uint64 // the nonce of the next executable tx.
+}
+
+// head return the next executable tx of the sender, nil if there is a nonce gap.
+func (list *accountTxs) head() *Transaction {
+	return list.txs[list.next]
+}
+
+// pendingLen return the count of executable txs.
+func (list *accountTxs) pendingLen() int {
+	n := 0
+	for _, ok := list.txs[list.next+uint64(n)]; ok; _, ok = list.txs[list.next+uint64(n)] {
+		n++
+	}
+	return n
+}
+
+// last return the tx with the largest nonce.
+func (list *accountTxs) last() *Transaction {
+	var last *Transaction
+	for _, tx := range list.txs {
+		if last == nil || tx.nonce > last.nonce {
+			last = tx
+		}
+	}
+	return last
 }
 
+// less orders the heads of senders by gasPrice, the tx with smaller gasLimit wins a tie.
 func less(a interface{}, b interface{}) bool {
 	txa := a.(*Transaction)
 	txb := b.(*Transaction)
-	if txa.from.Equals(txb.from) {
-		return txa.Nonce() < txb.Nonce()
-	}
 	if txa.gasPrice.Cmp(txb.gasPrice.Int) != 0 {
 		// txa.gasPrice < txb.gasPrice
 		return txa.GasPrice().Cmp(txb.GasPrice().Int) == -1
 	}
-	// txa.gasLimit < txb.gasLimit
-	return txa.GasLimit().Cmp(txb.GasLimit().Int) == -1
+	// txa.gasLimit > txb.gasLimit
+	return txa.GasLimit().Cmp(txb.GasLimit().Int) == 1
 }
 
 // NewTransactionPool create a new TransactionPool
@@ -78,10 +158,16 @@ func NewTransactionPool(size int) *TransactionPool {
 		receivedMessageCh: make(chan net.Message, 128),
 		quitCh:            make(chan int, 1),
 		size:              size,
-		cache:             pdeque.NewPriorityDeque(less),
+		accountSlots:      TransactionPoolAccountSlots,
+		lifetime:          TransactionPoolLifetime,
+		candidates:        pdeque.NewPriorityDeque(less),
+		accounts:          make(map[byteutils.HexHash]*accountTxs),
 		all:               make(map[byteutils.HexHash]*Transaction),
+		arrivals:          make(map[byteutils.HexHash]time.Time),
+		locals:            make(map[byteutils.HexHash]bool),
 		gasPrice:          TransactionGasPrice,
 		gasLimt:           TransactionMaxGas,
+		priceBump:         TransactionPriceBump,
 	}
 	return txPool
 }
@@ -98,6 +184,176 @@ func (pool *TransactionPool) SetGasConfig(gasPrice, gasLimit *util.Uint128) {
 	}
 }
 
+// SetPriceBump config the percentage by which a tx must raise the gasPrice to replace
+// the pooled tx with the same sender and nonce, 0 means the default.
+func (pool *TransactionPool) SetPriceBump(percent uint32) {
+	if percent == 0 {
+		pool.priceBump = TransactionPriceBump
+	} else {
+		pool.priceBump = percent
+	}
+}
+
+// SetLimits config the maximum count of txs, the count of txs a sender can keep
+// in a full pool and the maximum time a tx stays in pool, 0 means the default.
+func (pool *TransactionPool) SetLimits(size, accountSlots int, lifetime time.Duration) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if size <= 0 {
+		size = TransactionPoolSize
+	}
+	if accountSlots <= 0 {
+		accountSlots = TransactionPoolAccountSlots
+	}
+	if lifetime <= 0 {
+		lifetime = TransactionPoolLifetime
+	}
+	pool.size = size
+	pool.accountSlots = accountSlots
+	pool.lifetime = lifetime
+	for len(pool.all) > pool.size {
+		pool.evict()
+	}
+}
+
+// Status return the status and limits of the pool.
+func (pool *TransactionPool) Status() *TransactionPoolStatus {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	pending := 0
+	for _, list := range pool.accounts {
+		pending += list.pendingLen()
+	}
+	return &TransactionPoolStatus{
+		Count:        len(pool.all),
+		Pending:      pending,
+		Queued:       len(pool.all) - pending,
+		Accounts:     len(pool.accounts),
+		Size:         pool.size,
+		AccountSlots: pool.accountSlots,
+		Lifetime:     pool.lifetime,
+	}
+}
+
+// EnableJournal replay the journal of local txs into pool, skipping the mined or stale ones,
+// and keep journaling the local txs, the journal is rotated every interval, 0 means the default.
+func (pool *TransactionPool) EnableJournal(interval time.Duration) error {
+	if interval <= 0 {
+		interval = TransactionJournalInterval
+	}
+	journal := NewTransactionJournal(pool.bc.storage)
+	txs, err := journal.Load()
+	if err != nil {
+		return err
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	replayed := 0
+	for _, tx := range txs {
+		if err := pool.push(tx); err != nil {
+			log.WithFields(log.Fields{
+				"func": "TxPool.EnableJournal",
+				"tx":   tx,
+				"err":  err,
+			}).Debug("skip transaction in journal.")
+			continue
+		}
+		pool.locals[tx.from.address.Hex()] = true
+		replayed++
+	}
+	pool.journal = journal
+	pool.journalInterval = interval
+	dropped, err := pool.rotateJournal()
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"func":     "TxPool.EnableJournal",
+		"replayed": replayed,
+		"dropped":  dropped,
+	}).Info("replayed local transactions.")
+	return nil
+}
+
+// rotateJournal rewrite the journal with the pooled txs of local senders.
+func (pool *TransactionPool) rotateJournal() (int, error) {
+	var txs []*Transaction
+	for addr := range pool.locals {
+		if list, ok := pool.accounts[addr]; ok {
+			for _, tx := range list.txs {
+				txs = append(txs, tx)
+			}
+		}
+	}
+	return pool.journal.Rotate(txs)
+}
+
+func (pool *TransactionPool) rotateJournalAndLog() {
+	if pool.journal == nil {
+		return
+	}
+	dropped, err := pool.rotateJournal()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"func": "TxPool.rotateJournal",
+			"err":  err,
+		}).Error("failed to rotate journal.")
+		return
+	}
+	log.WithFields(log.Fields{
+		"func":    "TxPool.rotateJournal",
+		"dropped": dropped,
+	}).Debug("rotated journal.")
+}
+
+// GetTransaction return the pooled tx of given hash, nil if not found.
+func (pool *TransactionPool) GetTransaction(hash byteutils.Hash) *Transaction {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	return pool.all[hash.Hex()]
+}
+
+// GetAccountTransactions return the pending and queued txs of the sender, sorted by nonce.
+func (pool *TransactionPool) GetAccountTransactions(addr byteutils.Hash) ([]*Transaction, []*Transaction) {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	list, ok := pool.accounts[addr.Hex()]
+	if !ok {
+		return nil, nil
+	}
+	var pending, queued []*Transaction
+	for i := 0; i < list.pendingLen(); i++ {
+		pending = append(pending, list.txs[list.next+uint64(i)])
+	}
+	for _, tx := range list.txs {
+		if tx.nonce < list.next || tx.nonce >= list.next+uint64(len(pending)) {
+			queued = append(queued, tx)
+		}
+	}
+	sort.Slice(queued, func(i, j int) bool {
+		return queued[i].nonce < queued[j].nonce
+	})
+	return pending, queued
+}
+
+// GetNextNonce return the nonce of the next tx of the sender, following its pending txs.
+func (pool *TransactionPool) GetNextNonce(addr byteutils.Hash) uint64 {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	list, ok := pool.accounts[addr.Hex()]
+	if !ok {
+		return pool.bc.TailBlock().GetNonce(addr) + 1
+	}
+	return list.next + uint64(list.pendingLen())
+}
+
 // RegisterInNetwork register message subscriber in network.
 func (pool *TransactionPool) RegisterInNetwork(nm net.Manager) {
 	nm.Register(net.NewSubscriber(pool, pool.receivedMessageCh, MessageTypeNewTx))
@@ -123,14 +379,35 @@ func (pool *TransactionPool) loop() {
 		"func": "TxPool.loop",
 	}).Debug("running.")
 
+	expireTicker := time.NewTicker(expireInterval)
+	defer expireTicker.Stop()
+	// the journal is never rotated if it is disabled.
+	var journalCh <-chan time.Time
+	if pool.journal != nil {
+		journalTicker := time.NewTicker(pool.journalInterval)
+		defer journalTicker.Stop()
+		journalCh = journalTicker.C
+	}
+
 	count := 0
 	for {
 		select {
 		case <-pool.quitCh:
+			pool.mu.Lock()
+			pool.rotateJournalAndLog()
+			pool.mu.Unlock()
 			log.WithFields(log.Fields{
 				"func": "TxPool.loop",
 			}).Info("quit.")
 			return
+		case <-journalCh:
+			pool.mu.Lock()
+			pool.rotateJournalAndLog()
+			pool.mu.Unlock()
+		case <-expireTicker.C:
+			pool.mu.Lock()
+			pool.expire(time.Now())
+			pool.mu.Unlock()
 		case msg := <-pool.receivedMessageCh:
 			count++
 			log.WithFields(log.Fields{
@@ -185,9 +462,22 @@ func (pool *TransactionPool) PushAndRelay(tx *Transaction) error {
 	return nil
 }
 
-// PushAndBroadcast push tx into pool and broadcast it
+// PushAndBroadcast push the locally submitted tx into pool and broadcast it
 func (pool *TransactionPool) PushAndBroadcast(tx *Transaction) error {
-	if err := pool.Push(tx); err != nil {
+	pool.mu.Lock()
+	err := pool.push(tx)
+	if err == nil && pool.journal != nil {
+		pool.locals[tx.from.address.Hex()] = true
+		if err := pool.journal.Insert(tx); err != nil {
+			log.WithFields(log.Fields{
+				"func": "TxPool.PushAndBroadcast",
+				"tx":   tx,
+				"err":  err,
+			}).Error("failed to journal transaction.")
+		}
+	}
+	pool.mu.Unlock()
+	if err != nil {
 		return err
 	}
 	pool.nm.Broadcast(MessageTypeNewTx, tx)
@@ -217,18 +507,120 @@ func (pool *TransactionPool) push(tx *Transaction) error {
 		return err
 	}
 
+	list, ok := pool.accounts[tx.from.address.Hex()]
+	if !ok {
+		nonce := pool.bc.TailBlock().GetNonce(tx.from.address)
+		list = &accountTxs{
+			addr:  tx.from.address,
+			txs:   make(map[uint64]*Transaction),
+			nonce: nonce,
+			next:  nonce + 1,
+		}
+	}
+	if tx.nonce <= list.nonce {
+		return ErrSmallTransactionNonce
+	}
+	if old, ok := list.txs[tx.nonce]; ok {
+		// replace the pooled tx if the gasPrice is bumped enough.
+		if tx.gasPrice.Cmp(pool.replacementPrice(old).Int) < 0 {
+			underpricedTxCounter.Inc(1)
+			return ErrUnderpricedReplacement
+		}
+		replacedTxCounter.Inc(1)
+		log.WithFields(log.Fields{
+			"func": "TxPool.push",
+			"old":  old,
+			"new":  tx,
+		}).Debug("replace transaction.")
+		// the old head left in candidates is skipped by pop.
+		delete(pool.all, old.hash.Hex())
+		delete(pool.arrivals, old.hash.Hex())
+	}
+
 	// cache the verified tx
-	pool.cache.Insert(tx)
+	pool.accounts[tx.from.address.Hex()] = list
+	list.txs[tx.nonce] = tx
 	pool.all[tx.hash.Hex()] = tx
-	// delete tx with lowest priority if cache is full
-	if pool.cache.Len() > pool.size {
-		tx := pool.cache.PopMax().(*Transaction)
-		delete(pool.all, tx.hash.Hex())
+	pool.arrivals[tx.hash.Hex()] = time.Now()
+	// a tx given back from a block being minted moves the head backwards.
+	if tx.nonce < list.next {
+		list.next = tx.nonce
+	}
+	if tx.nonce == list.next {
+		pool.candidates.Insert(tx)
+	}
+	// delete tx with lowest priority if cache is full, the tx itself may be the one.
+	if len(pool.all) > pool.size {
+		pool.evict()
+		if _, ok := pool.all[tx.hash.Hex()]; !ok {
+			return ErrTxPoolFull
+		}
 	}
 	return nil
 }
 
-// Pop a transaction from pool
+// replacementPrice return the lowest gasPrice of a tx replacing the pooled tx.
+func (pool *TransactionPool) replacementPrice(old *Transaction) *util.Uint128 {
+	price := util.NewUint128().Mul(old.gasPrice.Int, util.NewUint128FromInt(int64(100+pool.priceBump)).Int)
+	price.Div(price, util.NewUint128FromInt(100).Int)
+	if price.Cmp(old.gasPrice.Int) <= 0 {
+		price.Add(old.gasPrice.Int, one.Int)
+	}
+	return util.NewUint128FromBigInt(price)
+}
+
+// evict drop the last tx of a sender, the senders over the account slots are chosen
+// first, then the ones with queued txs, and the one with the lowest gasPrice at last.
+func (pool *TransactionPool) evict() {
+	var victim *Transaction
+	victimOverQuota, victimQueued := false, false
+	for _, list := range pool.accounts {
+		last := list.last()
+		overQuota := len(list.txs) > pool.accountSlots
+		queued := last.nonce >= list.next+uint64(list.pendingLen())
+		if victim == nil || evictBefore(overQuota, queued, last, victimOverQuota, victimQueued, victim) {
+			victim = last
+			victimOverQuota, victimQueued = overQuota, queued
+		}
+	}
+	if victim != nil {
+		evictedTxCounter.Inc(1)
+		pool.remove(victim)
+	}
+}
+
+func evictBefore(overQuota, queued bool, tx *Transaction, victimOverQuota, victimQueued bool, victim *Transaction) bool {
+	if overQuota != victimOverQuota {
+		return overQuota
+	}
+	if queued != victimQueued {
+		return queued
+	}
+	return tx.gasPrice.Cmp(victim.gasPrice.Int) < 0
+}
+
+// expire drop the txs staying in pool longer than the lifetime.
+func (pool *TransactionPool) expire(now time.Time) {
+	for hash, arrival := range pool.arrivals {
+		if now.Sub(arrival) > pool.lifetime {
+			expiredTxCounter.Inc(1)
+			pool.remove(pool.all[hash])
+		}
+	}
+}
+
+// remove delete tx from the pool, a stale head left in candidates is skipped by pop.
+func (pool *TransactionPool) remove(tx *Transaction) {
+	delete(pool.all, tx.hash.Hex())
+	delete(pool.arrivals, tx.hash.Hex())
+	list := pool.accounts[tx.from.address.Hex()]
+	delete(list.txs, tx.nonce)
+	if len(list.txs) == 0 {
+		delete(pool.accounts, tx.from.address.Hex())
+	}
+}
+
+// Pop the executable transaction with the highest gasPrice from pool
 func (pool *TransactionPool) Pop() *Transaction {
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
@@ -236,17 +628,53 @@ func (pool *TransactionPool) Pop() *Transaction {
 }
 
 func (pool *TransactionPool) pop() *Transaction {
-	if pool.cache.Len() > 0 {
-		tx := pool.cache.PopMin().(*Transaction)
-		delete(pool.all, tx.hash.Hex())
+	for pool.candidates.Len() > 0 {
+		tx := pool.candidates.PopMax().(*Transaction)
+		list, ok := pool.accounts[tx.from.address.Hex()]
+		if !ok || list.head() != tx {
+			continue
+		}
+		pool.remove(tx)
+		list.next++
+		if next := list.head(); next != nil {
+			pool.candidates.Insert(next)
+		}
 		return tx
 	}
 	return nil
 }
 
-// Empty return if the pool is empty
+// Empty return if there is no executable tx in the pool
 func (pool *TransactionPool) Empty() bool {
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
-	return pool.cache.Len() == 0
+	for pool.candidates.Len() > 0 {
+		tx := pool.candidates.PopMax().(*Transaction)
+		if list, ok := pool.accounts[tx.from.address.Hex()]; ok && list.head() == tx {
+			pool.candidates.Insert(tx)
+			return false
+		}
+	}
+	return true
+}
+
+// reset drop the txs already on chain of the new tail block,
+// and promote the queued txs whose nonce gap is closed.
+func (pool *TransactionPool) reset(tail *Block) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	pool.candidates = pdeque.NewPriorityDeque(less)
+	for _, list := range pool.accounts {
+		list.nonce = tail.GetNonce(list.addr)
+		list.next = list.nonce + 1
+		for nonce, tx := range list.txs {
+			if nonce <= list.nonce {
+				pool.remove(tx)
+			}
+		}
+		if head := list.head(); head != nil {
+			pool.candidates.Insert(head)
+		}
+	}
 }
@@ -52,6 +52,7 @@ func TestTransactionPool(t *testing.T) {
 	signature2.InitSign(key2.(keystore.PrivateKey))
 
 	heighPrice := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(2).Int))
+	bumpedPrice := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(3).Int))
 
 	txs := []*Transaction{
 		NewTransaction(0, from, &Address{[]byte("to")}, util.NewUint128(), 10, TxPayloadBinaryType, []byte("datadata"), TransactionGasPrice, util.NewUint128FromInt(200000)),
@@ -61,8 +62,10 @@ func TestTransactionPool(t *testing.T) {
 		NewTransaction(0, from, &Address{[]byte("to")}, util.NewUint128(), 2, TxPayloadBinaryType, []byte("da"), TransactionGasPrice, util.NewUint128FromInt(200000)),
 		NewTransaction(1, from, &Address{[]byte("to")}, util.NewUint128(), 0, TxPayloadBinaryType, []byte("da"), TransactionGasPrice, util.NewUint128FromInt(200000)),
 
-		NewTransaction(0, other, &Address{[]byte("to")}, util.NewUint128(), 1, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000)),
-		NewTransaction(0, from, &Address{[]byte("to")}, util.NewUint128(), 1, TxPayloadBinaryType, []byte("datadata"), heighPrice, util.NewUint128FromInt(200000)),
+		NewTransaction(0, from, &Address{[]byte("to")}, util.NewUint128(), 0, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000)),
+		NewTransaction(0, from, &Address{[]byte("to")}, util.NewUint128(), 1, TxPayloadBinaryType, []byte("datadata"), bumpedPrice, util.NewUint128FromInt(200000)),
+		NewTransaction(0, from, &Address{[]byte("to")}, util.NewUint128(), 2, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000)),
+		NewTransaction(0, from, &Address{[]byte("to")}, util.NewUint128(), 3, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000)),
 	}
 
 	txPool := NewTransactionPool(3)
@@ -81,36 +84,164 @@ func TestTransactionPool(t *testing.T) {
 	// put tx with different chainID, should fail
 	assert.Nil(t, txs[4].Sign(signature1))
 	assert.NotNil(t, txPool.Push(txs[4]))
-	// put one new, replace txs[1]
+	// put tx with nonce already on chain, should fail
+	assert.Nil(t, txs[5].Sign(signature1))
+	assert.Equal(t, txPool.Push(txs[5]), ErrSmallTransactionNonce)
 	assert.Equal(t, len(txPool.all), 3)
-	assert.Equal(t, txPool.cache.Len(), 3)
+	assert.Equal(t, len(txPool.accounts), 2)
+
+	// put tx with nonce already in pool and bumped gasPrice, replace txs[2]
+	assert.Equal(t, txPool.replacementPrice(txs[2]).String(), "1100000")
 	assert.Nil(t, txs[6].Sign(signature1))
 	assert.Nil(t, txPool.Push(txs[6]))
-	assert.Equal(t, txPool.cache.Len(), 3)
 	assert.Equal(t, len(txPool.all), 3)
-	// get from: other, nonce: 1, data: "da"
-	tx1 := txPool.Pop()
-	assert.Equal(t, txs[2].from.address, tx1.from.address)
-	assert.Equal(t, txs[2].nonce, tx1.nonce)
-	assert.Equal(t, txs[2].data, tx1.data)
-	// put one new
-	assert.Equal(t, len(txPool.all), 2)
-	assert.Equal(t, txPool.cache.Len(), 2)
-	assert.Nil(t, txs[5].Sign(signature2))
-	assert.Nil(t, txPool.Push(txs[5]))
+	assert.Nil(t, txPool.all[txs[2].hash.Hex()])
+	// put the replaced one back, should fail
+	assert.Equal(t, txPool.Push(txs[2]), ErrUnderpricedReplacement)
+
+	// put one new, evict the queued txs[0]
+	assert.Nil(t, txs[7].Sign(signature1))
+	assert.Nil(t, txPool.Push(txs[7]))
 	assert.Equal(t, len(txPool.all), 3)
-	assert.Equal(t, txPool.cache.Len(), 3)
-	// get 2 txs, txs[5], txs[0]
-	tx21 := txPool.Pop()
-	tx22 := txPool.Pop()
-	assert.Equal(t, txs[5].from.address, tx21.from.address)
-	assert.Equal(t, txs[5].Nonce(), tx21.Nonce())
-	assert.Equal(t, txs[5].data, tx21.data)
-	assert.Equal(t, txs[6].from.address, tx22.from.address)
-	assert.Equal(t, txs[6].Nonce(), tx22.Nonce())
-	assert.Equal(t, txs[6].data, tx22.data)
-	assert.Equal(t, txPool.Empty(), false)
-	txPool.Pop()
+	assert.Nil(t, txPool.all[txs[0].hash.Hex()])
+
+	// pop executable txs by gasPrice, then by nonce of the sender.
+	assert.Equal(t, txPool.Pop(), txs[6])
+	assert.Equal(t, txPool.Pop(), txs[1])
+	assert.Equal(t, txPool.Pop(), txs[7])
 	assert.Equal(t, txPool.Empty(), true)
 	assert.Nil(t, txPool.Pop())
+	assert.Equal(t, len(txPool.all), 0)
+	assert.Equal(t, len(txPool.accounts), 0)
+
+	// queued tx is promoted when the nonce gap closes.
+	assert.Nil(t, txs[8].Sign(signature1))
+	assert.Nil(t, txPool.Push(txs[8]))
+	assert.Equal(t, txPool.Empty(), true)
+	assert.Nil(t, txPool.Push(txs[2]))
+	assert.Equal(t, txPool.Empty(), false)
+	assert.Nil(t, txPool.Push(txs[7]))
+	assert.Equal(t, txPool.Pop(), txs[2])
+	assert.Equal(t, txPool.Pop(), txs[7])
+	assert.Equal(t, txPool.Pop(), txs[8])
+	assert.Equal(t, txPool.Empty(), true)
+}
+
+// mockPoolTxs return a func creating signed txs from n senders.
+func mockPoolTxs(t *testing.T, n int) ([]*Address, func(i int, nonce uint64, gasPrice *util.Uint128) *Transaction) {
+	ks := keystore.DefaultKS
+	var addrs []*Address
+	var signatures []keystore.Signature
+	for i := 0; i < n; i++ {
+		priv := secp256k1.GeneratePrivateKey()
+		pubdata, _ := priv.PublicKey().Encoded()
+		addr, _ := NewAddressFromPublicKey(pubdata)
+		ks.SetKey(addr.String(), priv, []byte("passphrase"))
+		ks.Unlock(addr.String(), []byte("passphrase"), time.Second*60*60*24*365)
+		key, _ := ks.GetUnlocked(addr.String())
+		signature, _ := crypto.NewSignature(keystore.SECP256K1)
+		signature.InitSign(key.(keystore.PrivateKey))
+		addrs = append(addrs, addr)
+		signatures = append(signatures, signature)
+	}
+	return addrs, func(i int, nonce uint64, gasPrice *util.Uint128) *Transaction {
+		tx := NewTransaction(0, addrs[i], &Address{[]byte("to")}, util.NewUint128(), nonce, TxPayloadBinaryType, []byte("data"), gasPrice, util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signatures[i]))
+		return tx
+	}
+}
+
+func TestTransactionPool_Limits(t *testing.T) {
+	_, newTx := mockPoolTxs(t, 3)
+	heighPrice := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(2).Int))
+
+	txPool := NewTransactionPool(TransactionPoolSize)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+	txPool.SetLimits(3, 1, time.Hour)
+	status := txPool.Status()
+	assert.Equal(t, status.Size, 3)
+	assert.Equal(t, status.AccountSlots, 1)
+	assert.Equal(t, status.Lifetime, time.Hour)
+
+	// the sender over quota is evicted first, even with higher gasPrice.
+	tx1 := newTx(0, 1, heighPrice)
+	tx2 := newTx(0, 2, heighPrice)
+	tx3 := newTx(1, 1, TransactionGasPrice)
+	tx4 := newTx(2, 1, TransactionGasPrice)
+	assert.Nil(t, txPool.Push(tx1))
+	assert.Nil(t, txPool.Push(tx2))
+	assert.Nil(t, txPool.Push(tx3))
+	assert.Nil(t, txPool.Push(tx4))
+	assert.Equal(t, len(txPool.all), 3)
+	assert.Nil(t, txPool.all[tx2.hash.Hex()])
+	assert.NotNil(t, txPool.all[tx1.hash.Hex()])
+
+	// txs staying longer than the lifetime are dropped.
+	txPool.arrivals[tx3.hash.Hex()] = time.Now().Add(-2 * time.Hour)
+	txPool.expire(time.Now())
+	assert.Equal(t, len(txPool.all), 2)
+	assert.Nil(t, txPool.all[tx3.hash.Hex()])
+	status = txPool.Status()
+	assert.Equal(t, status.Count, 2)
+	assert.Equal(t, status.Accounts, 2)
+}
+
+func TestTransactionPool_Underpriced(t *testing.T) {
+	_, newTx := mockPoolTxs(t, 3)
+	heighPrice := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(2).Int))
+
+	txPool := NewTransactionPool(2)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+	tx1 := newTx(0, 1, heighPrice)
+	tx2 := newTx(1, 1, heighPrice)
+	assert.Nil(t, txPool.Push(tx1))
+	assert.Nil(t, txPool.Push(tx2))
+
+	// a full pool refuses the tx with the lowest priority, and keeps the others.
+	tx3 := newTx(2, 1, TransactionGasPrice)
+	assert.Equal(t, ErrTxPoolFull, txPool.Push(tx3))
+	assert.Nil(t, txPool.GetTransaction(tx3.hash))
+	assert.Equal(t, len(txPool.all), 2)
+	assert.Equal(t, len(txPool.accounts), 2)
+	assert.NotNil(t, txPool.all[tx1.hash.Hex()])
+	assert.NotNil(t, txPool.all[tx2.hash.Hex()])
+
+	// it is not broadcast either.
+	assert.Equal(t, ErrTxPoolFull, txPool.PushAndBroadcast(tx3))
+
+	// a tx with higher priority still evicts the lowest one.
+	tx4 := newTx(2, 1, util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(3).Int)))
+	assert.Nil(t, txPool.Push(tx4))
+	assert.Equal(t, len(txPool.all), 2)
+	assert.NotNil(t, txPool.all[tx4.hash.Hex()])
+}
+
+func TestTransactionPool_Inspect(t *testing.T) {
+	addrs, newTx := mockPoolTxs(t, 2)
+	txPool := NewTransactionPool(TransactionPoolSize)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+	assert.Equal(t, txPool.GetNextNonce(addrs[0].address), uint64(1))
+
+	tx1 := newTx(0, 1, TransactionGasPrice)
+	tx2 := newTx(0, 2, TransactionGasPrice)
+	tx4 := newTx(0, 4, TransactionGasPrice)
+	tx5 := newTx(0, 5, TransactionGasPrice)
+	for _, tx := range []*Transaction{tx5, tx1, tx4, tx2, newTx(1, 1, TransactionGasPrice)} {
+		assert.Nil(t, txPool.Push(tx))
+	}
+	status := txPool.Status()
+	assert.Equal(t, status.Count, 5)
+	assert.Equal(t, status.Pending, 3)
+	assert.Equal(t, status.Queued, 2)
+
+	pending, queued := txPool.GetAccountTransactions(addrs[0].address)
+	assert.Equal(t, pending, []*Transaction{tx1, tx2})
+	assert.Equal(t, queued, []*Transaction{tx4, tx5})
+	assert.Equal(t, txPool.GetNextNonce(addrs[0].address), uint64(3))
+	assert.Equal(t, txPool.GetNextNonce(addrs[1].address), uint64(2))
+	assert.Equal(t, txPool.GetTransaction(tx4.hash), tx4)
+	assert.Nil(t, txPool.GetTransaction(newTx(0, 3, TransactionGasPrice).hash))
 }
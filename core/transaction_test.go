@@ -19,7 +19,10 @@
 package core
 
 import (
+	"encoding/json"
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -28,8 +31,10 @@ import (
 	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestTransaction(t *testing.T) {
@@ -140,3 +145,196 @@ func TestTransactionVerify(t *testing.T) {
 		}
 	}
 }
+
+func TestTransaction_RecordResultEvent(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	pubdata, _ := secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+	addr, _ := NewAddressFromPublicKey(pubdata)
+	block, _ := NewBlock(0, addr, bc.tailBlock)
+	block.begin()
+
+	tx := NewTransaction(0, addr, addr, util.NewUint128(), 1, TxPayloadBinaryType, nil, TransactionGasPrice, TransactionGas)
+	tx.hash, _ = HashTransaction(tx)
+	result := strings.Repeat("x", MaxExecutionResultLength+1)
+	assert.Nil(t, tx.recordResultEvent(block, TransactionGas, result, nil))
+
+	// the result in events trie is capped.
+	event, err := block.FetchExecutionResultEvent(tx.hash)
+	assert.Nil(t, err)
+	assert.Equal(t, result[:MaxExecutionResultLength], event.Result)
+}
+
+func TestTransaction_ContractLifecycle(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	newAddress := func() *Address {
+		pubdata, _ := secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+		addr, _ := NewAddressFromPublicKey(pubdata)
+		return addr
+	}
+	owner, other := newAddress(), newAddress()
+
+	block, _ := NewBlock(0, newAddress(), bc.tailBlock)
+	block.begin()
+	block.accState.GetOrCreateUserAccount(owner.Bytes()).AddBalance(util.NewUint128FromInt(1000000000000000))
+	block.accState.GetOrCreateUserAccount(other.Bytes()).AddBalance(util.NewUint128FromInt(1000000000000000))
+
+	gasLimit := util.NewUint128FromInt(200000)
+	bytes, _ := NewDeployPayload("v1", "js", "").ToBytes()
+	deployTx := NewTransaction(0, owner, owner, util.NewUint128(), 1, TxPayloadDeployType, bytes, TransactionGasPrice, gasLimit)
+	deployTx.hash, _ = HashTransaction(deployTx)
+	assert.Nil(t, block.acceptTransaction(deployTx))
+	contractAddr, _ := deployTx.GenerateContractAddress()
+	// a contract deployed before codes are stored by hash.
+	contract, _ := block.accState.CreateContractAccount(contractAddr.Bytes(), deployTx.Hash(), nil, nil)
+	contract.AddBalance(util.NewUint128FromInt(100))
+
+	source, err := block.GetContractSource(contract)
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", source.Source)
+	assert.Equal(t, CodeHash("js", "v1"), source.CodeHash)
+
+	// execute a tx to the contract and return the gas used and its recorded events.
+	nonce := uint64(1)
+	execute := func(from *Address, value int64, payloadType string, payload TxPayload) (*util.Uint128, []*Event) {
+		nonce++
+		bytes, _ := payload.ToBytes()
+		tx := NewTransaction(0, from, contractAddr, util.NewUint128FromInt(value), nonce, payloadType, bytes, TransactionGasPrice, gasLimit)
+		tx.hash, _ = HashTransaction(tx)
+		gas, err := tx.Execute(block)
+		assert.Nil(t, err)
+		events, err := block.FetchEvents(tx.hash)
+		assert.Nil(t, err)
+		return gas, events
+	}
+	assertFailed := func(events []*Event, want error) {
+		assert.Equal(t, 1, len(events))
+		txEvent := new(TransactionEvent)
+		assert.Nil(t, json.Unmarshal([]byte(events[0].Data), txEvent))
+		assert.Equal(t, TopicExecuteTxFailed, events[0].Topic)
+		assert.Equal(t, want.Error(), txEvent.Error)
+	}
+
+	// only the owner can upgrade, with a supported source type.
+	_, events := execute(other, 0, TxPayloadUpgradeType, NewUpgradePayload("v2", "js"))
+	assertFailed(events, ErrNotContractOwner)
+	_, events = execute(owner, 0, TxPayloadUpgradeType, NewUpgradePayload("v2", "py"))
+	assertFailed(events, nvm.ErrUnsupportedSourceType)
+	assert.Equal(t, uint32(0), contract.CodeVersion())
+
+	for version, code := range []string{"v2", "v3"} {
+		gas, events := execute(owner, 0, TxPayloadUpgradeType, NewUpgradePayload(code, "js"))
+		assert.Equal(t, 2, len(events))
+		// the source is charged on top of the data of tx.
+		bytes, _ := NewUpgradePayload(code, "js").ToBytes()
+		minGas := util.NewUint128FromInt(int64(len(code)))
+		minGas.Mul(minGas.Int, ContractCodeGas.Int)
+		minGas.Add(minGas.Int, util.NewUint128().Mul(util.NewUint128FromInt(int64(len(bytes))).Int, TransactionDataGas.Int))
+		minGas.Add(minGas.Int, TransactionGas.Int)
+		assert.True(t, gas.Cmp(minGas.Int) >= 0)
+		assert.Equal(t, TopicUpgradeSmartContract, events[0].Topic)
+		assert.Equal(t, TopicExecuteTxSuccess, events[1].Topic)
+		upgrade := new(ContractEvent)
+		assert.Nil(t, json.Unmarshal([]byte(events[0].Data), upgrade))
+		assert.Equal(t, uint32(version+1), upgrade.Version)
+		assert.Equal(t, CodeHash("js", code).String(), upgrade.CodeHash)
+
+		assert.Equal(t, uint32(version+1), contract.CodeVersion())
+		assert.Equal(t, CodeHash("js", code), contract.CodeHash())
+		source, err = block.GetContractSource(contract)
+		assert.Nil(t, err)
+		assert.Equal(t, code, source.Source)
+		assert.Equal(t, "", source.Traceable)
+		assert.Equal(t, owner.Bytes(), []byte(source.Owner))
+	}
+
+	// only the owner can destruct, without value.
+	_, events = execute(other, 0, TxPayloadDestructType, NewDestructPayload())
+	assertFailed(events, ErrNotContractOwner)
+	_, events = execute(owner, 1, TxPayloadDestructType, NewDestructPayload())
+	assertFailed(events, ErrDestructWithValue)
+
+	ownerAcc := block.accState.GetOrCreateUserAccount(owner.Bytes())
+	balance := util.NewUint128()
+	balance.Add(balance.Int, ownerAcc.Balance().Int)
+	gas, events := execute(owner, 0, TxPayloadDestructType, NewDestructPayload())
+	assert.Equal(t, 2, len(events))
+	assert.Equal(t, TopicDestructSmartContract, events[0].Topic)
+	destruct := new(ContractEvent)
+	assert.Nil(t, json.Unmarshal([]byte(events[0].Data), destruct))
+	assert.Equal(t, "100", destruct.Refund)
+	assert.Equal(t, uint32(2), destruct.Version)
+
+	balance.Add(balance.Int, util.NewUint128FromInt(100).Int)
+	balance.Sub(balance.Int, util.NewUint128().Mul(gas.Int, TransactionGasPrice.Int))
+	assert.Equal(t, balance.String(), ownerAcc.Balance().String())
+	assert.Equal(t, "0", contract.Balance().String())
+	assert.True(t, contract.Destroyed())
+
+	// a destructed contract can't be called, upgraded or destructed again.
+	_, err = block.GetContractSource(contract)
+	assert.Equal(t, ErrContractDestroyed, err)
+	_, events = execute(owner, 0, TxPayloadCallType, NewCallPayload("get", ""))
+	assertFailed(events, ErrContractDestroyed)
+	_, events = execute(owner, 0, TxPayloadUpgradeType, NewUpgradePayload("v4", "js"))
+	assertFailed(events, ErrContractDestroyed)
+	_, events = execute(owner, 0, TxPayloadDestructType, NewDestructPayload())
+	assertFailed(events, ErrContractDestroyed)
+	_, events = execute(owner, 1, TxPayloadBinaryType, NewBinaryPayload(nil))
+	assertFailed(events, ErrContractDestroyed)
+	_, events = execute(owner, 1, TxPayloadCandidateType, NewCandidatePayload(LoginAction))
+	assertFailed(events, ErrContractDestroyed)
+	assert.Equal(t, "0", contract.Balance().String())
+
+	// nor can another contract transfer value to it.
+	payerSource := `var Payer = function () {};
+Payer.prototype = {
+	init: function () {},
+	pay: function (to) {
+		if (Blockchain.transfer(to, 1) !== 0) {
+			throw new Error("transfer failed");
+		}
+	}
+};
+module.exports = Payer;`
+	nonce++
+	bytes, _ = NewDeployPayload(payerSource, "js", "").ToBytes()
+	payerTx := NewTransaction(0, owner, owner, util.NewUint128(), nonce, TxPayloadDeployType, bytes, TransactionGasPrice, gasLimit)
+	payerTx.hash, _ = HashTransaction(payerTx)
+	_, err = payerTx.Execute(block)
+	assert.Nil(t, err)
+	payerAddr, _ := payerTx.GenerateContractAddress()
+	payer, err := block.accState.GetContractAccount(payerAddr.Bytes())
+	assert.Nil(t, err)
+	payer.AddBalance(util.NewUint128FromInt(10))
+
+	pay := func(to *Address) []*Event {
+		nonce++
+		bytes, _ := NewCallPayload("pay", fmt.Sprintf("[\"%s\"]", to.String())).ToBytes()
+		tx := NewTransaction(0, owner, payerAddr, util.NewUint128(), nonce, TxPayloadCallType, bytes, TransactionGasPrice, gasLimit)
+		tx.hash, _ = HashTransaction(tx)
+		_, err := tx.Execute(block)
+		assert.Nil(t, err)
+		events, err := block.FetchEvents(tx.hash)
+		assert.Nil(t, err)
+		return events
+	}
+	events = pay(other)
+	assert.Equal(t, TopicExecuteTxSuccess, events[len(events)-1].Topic)
+	payer, _ = block.accState.GetContractAccount(payerAddr.Bytes())
+	assert.Equal(t, "9", payer.Balance().String())
+
+	events = pay(contractAddr)
+	assert.Equal(t, TopicExecuteTxFailed, events[len(events)-1].Topic)
+	payer, _ = block.accState.GetContractAccount(payerAddr.Bytes())
+	assert.Equal(t, "9", payer.Balance().String())
+	contract, _ = block.accState.GetContractAccount(contractAddr.Bytes())
+	assert.Equal(t, "0", contract.Balance().String())
+
+	// the lifecycle survives the state trie.
+	block.commit()
+	contract, err = block.accState.GetContractAccount(contractAddr.Bytes())
+	assert.Nil(t, err)
+	assert.True(t, contract.Destroyed())
+	assert.Equal(t, uint32(2), contract.CodeVersion())
+	assert.Equal(t, CodeHash("js", "v3"), contract.CodeHash())
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// UpgradePayload carry the new code of a contract
+type UpgradePayload struct {
+	SourceType string
+	Source     string
+}
+
+// LoadUpgradePayload from bytes
+func LoadUpgradePayload(bytes []byte) (*UpgradePayload, error) {
+	payload := &UpgradePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewUpgradePayload with source
+func NewUpgradePayload(source, sourceType string) *UpgradePayload {
+	return &UpgradePayload{
+		Source:     source,
+		SourceType: sourceType,
+	}
+}
+
+// ToBytes serialize payload
+func (payload *UpgradePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// Execute the upgrade payload in tx, replace the code of tx.to and keep its storage
+func (payload *UpgradePayload) Execute(tx *Transaction, block *Block) (*util.Uint128, string, error) {
+	if payload.SourceType != nvm.SourceTypeJavaScript && payload.SourceType != nvm.SourceTypeTypeScript {
+		return nil, "", nvm.ErrUnsupportedSourceType
+	}
+	contract, err := loadOwnedContract(tx, block)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// the source is charged by size, and prepared within the gas left.
+	executionInstructions := util.NewUint128()
+	executionInstructions.Sub(tx.gasLimit.Int, tx.CalculateGas().Int)
+	codeGas := util.NewUint128()
+	codeGas.Mul(util.NewUint128FromInt(int64(len(payload.Source))).Int, ContractCodeGas.Int)
+	if executionInstructions.Cmp(codeGas.Int) < 0 {
+		return executionInstructions, "", nvm.ErrInsufficientGas
+	}
+	executionInstructions.Sub(executionInstructions.Int, codeGas.Int)
+
+	owner := block.accState.GetOrCreateUserAccount(tx.from.Bytes())
+	engine := nvm.NewV8Engine(nvm.NewContext(block, convertNvmTx(tx), owner, contract, block.accState))
+	defer engine.Dispose()
+	engine.SetExecutionLimits(executionInstructions.Uint64(), nvm.DefaultLimitsOfTotalMemorySize)
+
+	// the source must be prepared before it is stored.
+	_, _, err = engine.PrepareContractScript(payload.Source, payload.SourceType)
+	gas := util.NewUint128FromInt(int64(engine.ExecutionInstructions()))
+	gas.Add(gas.Int, codeGas.Int)
+	if err != nil {
+		return gas, "", err
+	}
+	codeHash, err := storeContractCode(block.storage, &ContractCode{SourceType: payload.SourceType, Source: payload.Source})
+	if err != nil {
+		return gas, "", err
+	}
+	if err := recordContractEvent(tx, block, TopicUpgradeSmartContract, &ContractEvent{
+		Address:  tx.to.String(),
+		Owner:    tx.from.String(),
+		CodeHash: codeHash.String(),
+		Version:  contract.CodeVersion() + 1,
+	}); err != nil {
+		return gas, "", err
+	}
+	contract.UpgradeCode(codeHash)
+	return gas, "", nil
+}
+
+// loadOwnedContract return the live contract at tx.to if tx.from deployed it.
+func loadOwnedContract(tx *Transaction, block *Block) (state.Account, error) {
+	contract, err := block.accState.GetContractAccount(tx.to.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if len(contract.BirthPlace()) == 0 {
+		return nil, ErrInvalidContractAddress
+	}
+	if contract.Destroyed() {
+		return nil, ErrContractDestroyed
+	}
+	owner, err := block.contractOwner(contract)
+	if err != nil {
+		return nil, err
+	}
+	if !owner.Equals(tx.from.Bytes()) {
+		return nil, ErrNotContractOwner
+	}
+	return contract, nil
+}
+
+func recordContractEvent(tx *Transaction, block *Block, topic string, contractEvent *ContractEvent) error {
+	data, err := json.Marshal(contractEvent)
+	if err != nil {
+		return err
+	}
+	return block.recordEvent(tx.hash, &Event{Topic: topic, Data: string(data)})
+}
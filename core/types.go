@@ -24,6 +24,7 @@ import (
 	"strconv"
 
 	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/state"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 )
@@ -35,6 +36,8 @@ const (
 	TxPayloadCallType      = "call"
 	TxPayloadDelegateType  = "delegate"
 	TxPayloadCandidateType = "candidate"
+	TxPayloadUpgradeType   = "upgrade"
+	TxPayloadDestructType  = "destruct"
 )
 
 // Error Types
@@ -56,6 +59,8 @@ var (
 	ErrInvalidBlockDposContextRoot       = errors.New("invalid block dpos context root hash")
 	ErrInvalidChainID                    = errors.New("invalid transaction chainID")
 	ErrDuplicatedTransaction             = errors.New("duplicated transaction")
+	ErrTxPoolFull                        = errors.New("transaction pool is full of transactions with higher priority")
+	ErrUnderpricedReplacement            = errors.New("replacement transaction gasPrice is not bumped enough")
 	ErrSmallTransactionNonce             = errors.New("cannot accept a transaction with smaller nonce")
 	ErrLargeTransactionNonce             = errors.New("cannot accept a transaction with too bigger nonce")
 	ErrDuplicatedBlock                   = errors.New("duplicated block")
@@ -69,6 +74,18 @@ var (
 	ErrInvalidUnDelegateFromNonDelegatee = errors.New("cannot un-delegate from non-delegatee")
 	ErrInvalidBaseAndNextDynastyID       = errors.New("cannot kickout from baseDynastyID to nextDynastyID if nextDynastyID <= baseDynastyID")
 	ErrInitialDynastyNotEnough           = errors.New("the size of initial dynasty in genesis block is un-safe, should be greater than or equal " + strconv.Itoa(SafeSize))
+	ErrTransactionNotFound               = errors.New("cannot find the transaction in canonical chain")
+	ErrExecutionResultNotFound           = errors.New("cannot find the execution result of the transaction")
+	ErrNotCallTransaction                = errors.New("transaction is not a contract call")
+	ErrInvalidBlockRange                 = errors.New("invalid block height range")
+	ErrLinkParentBlock                   = errors.New("cannot link the block to its parent block")
+	ErrStatePruned                       = errors.New("the state of the block has been pruned")
+	ErrSnapshotOnNonEmptyChain           = errors.New("cannot import snapshot into a chain with blocks other than genesis")
+	ErrRevertIrreversibleBlock           = errors.New("cannot revert the last irreversible block")
+	ErrContractDestroyed                 = state.ErrContractDestroyed
+	ErrInvalidContractCode               = errors.New("invalid contract code")
+	ErrNotContractOwner                  = errors.New("only the owner can upgrade or destruct the contract")
+	ErrDestructWithValue                 = errors.New("cannot transfer value to a destructing contract")
 )
 
 var (
@@ -78,7 +95,7 @@ var (
 // TxPayload stored in tx
 type TxPayload interface {
 	ToBytes() ([]byte, error)
-	Execute(tx *Transaction, block *Block) (*util.Uint128, error)
+	Execute(tx *Transaction, block *Block) (*util.Uint128, string, error)
 }
 
 // MessageType
@@ -0,0 +1,177 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+// Package light verifies the merkle proofs returned by the rpc of full nodes,
+// against the roots in a block header trusted by its hash.
+package light
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie/pb"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"golang.org/x/crypto/sha3"
+)
+
+// node types in trie, same as common/trie.
+const (
+	extNode  = 1
+	leafNode = 2
+)
+
+// Errors
+var (
+	ErrInvalidBlockHash = errors.New("block hash does not match the trusted hash")
+	ErrInvalidProof     = errors.New("invalid merkle proof")
+	ErrKeyMismatch      = errors.New("the key is not proved by the merkle proof")
+)
+
+// VerifyBlock check the hash computed from the header and transactions of block matches the trusted hash,
+// then the roots in the header can be trusted.
+func VerifyBlock(block *corepb.Block, trustedHash []byte) error {
+	header := block.Header
+	if header == nil || header.DposContext == nil {
+		return ErrInvalidBlockHash
+	}
+	dposContext := header.DposContext
+	dposHasher := sha3.New256()
+	dposHasher.Write(dposContext.DynastyRoot)
+	dposHasher.Write(dposContext.NextDynastyRoot)
+	dposHasher.Write(dposContext.DelegateRoot)
+	dposHasher.Write(dposContext.VoteRoot)
+	dposHasher.Write(dposContext.CandidateRoot)
+	dposHasher.Write(dposContext.MintCntRoot)
+
+	hasher := sha3.New256()
+	hasher.Write(header.ParentHash)
+	hasher.Write(header.StateRoot)
+	hasher.Write(header.TxsRoot)
+	hasher.Write(header.EventsRoot)
+	hasher.Write(dposHasher.Sum(nil))
+	hasher.Write(byteutils.FromUint64(header.Nonce))
+	hasher.Write(header.Coinbase)
+	hasher.Write(byteutils.FromInt64(header.Timestamp))
+	hasher.Write(byteutils.FromUint32(header.ChainId))
+	for _, tx := range block.Transactions {
+		hasher.Write(tx.Hash)
+	}
+
+	blockHash := hasher.Sum(nil)
+	if !bytes.Equal(blockHash, trustedHash) || !bytes.Equal(blockHash, header.Hash) {
+		return ErrInvalidBlockHash
+	}
+	return nil
+}
+
+// VerifyProof return the value of key proved by proof against the trie root,
+// each element of the proof is a marshaled trie node from the root to the leaf.
+func VerifyProof(root []byte, key []byte, proof [][]byte) ([]byte, error) {
+	route := keyToRoute(key)
+	wantHash := root
+	for _, ir := range proof {
+		if !bytes.Equal(hash.Sha3256(ir), wantHash) {
+			return nil, ErrInvalidProof
+		}
+		n := new(triepb.Node)
+		if err := proto.Unmarshal(ir, n); err != nil {
+			return nil, err
+		}
+		switch len(n.Val) {
+		case 16: // Branch Node
+			if len(route) == 0 {
+				return nil, ErrKeyMismatch
+			}
+			wantHash = n.Val[route[0]]
+			route = route[1:]
+		case 3: // Extension Node or Leaf Node
+			if len(n.Val[0]) == 0 {
+				return nil, ErrInvalidProof
+			}
+			path := n.Val[1]
+			if len(path) > len(route) || !bytes.Equal(path, route[:len(path)]) {
+				return nil, ErrKeyMismatch
+			}
+			switch n.Val[0][0] {
+			case extNode:
+				wantHash = n.Val[2]
+				route = route[len(path):]
+			case leafNode:
+				if len(path) != len(route) {
+					return nil, ErrKeyMismatch
+				}
+				return n.Val[2], nil
+			default:
+				return nil, ErrInvalidProof
+			}
+		default:
+			return nil, ErrInvalidProof
+		}
+	}
+	return nil, ErrInvalidProof
+}
+
+// VerifyAccount return the account proved against the state root.
+func VerifyAccount(stateRoot []byte, address []byte, proof [][]byte) (*corepb.Account, error) {
+	value, err := VerifyProof(stateRoot, address, proof)
+	if err != nil {
+		return nil, err
+	}
+	account := new(corepb.Account)
+	if err := proto.Unmarshal(value, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// VerifyStorage return the value of key in the contract storage proved against the state root.
+func VerifyStorage(stateRoot []byte, address []byte, key []byte, accountProof [][]byte, storageProof [][]byte) ([]byte, error) {
+	account, err := VerifyAccount(stateRoot, address, accountProof)
+	if err != nil {
+		return nil, err
+	}
+	return VerifyProof(account.VarsHash, key, storageProof)
+}
+
+// VerifyTransaction return the transaction proved against the txs root.
+func VerifyTransaction(txsRoot []byte, txHash []byte, proof [][]byte) (*corepb.Transaction, error) {
+	value, err := VerifyProof(txsRoot, txHash, proof)
+	if err != nil {
+		return nil, err
+	}
+	tx := new(corepb.Transaction)
+	if err := proto.Unmarshal(value, tx); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(tx.Hash, txHash) {
+		return nil, ErrKeyMismatch
+	}
+	return tx, nil
+}
+
+func keyToRoute(key []byte) []byte {
+	route := make([]byte, len(key)*2)
+	for i, b := range key {
+		route[i*2] = b / 16
+		route[i*2+1] = b % 16
+	}
+	return route
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package light
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/common/trie/pb"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func marshalProof(t *testing.T, merkleProof trie.MerkleProof) [][]byte {
+	var proof [][]byte
+	for _, val := range merkleProof {
+		ir, err := proto.Marshal(&triepb.Node{Val: val})
+		assert.Nil(t, err)
+		proof = append(proof, ir)
+	}
+	return proof
+}
+
+func TestVerifyProof(t *testing.T) {
+	s, _ := storage.NewMemoryStorage()
+	tr, _ := trie.NewTrie(nil, s)
+	names := []string{"1f345678e9", "1f355678e9", "1f555678e9", "2f345678e9"}
+	for _, v := range names {
+		key, _ := byteutils.FromHex(v)
+		tr.Put(key, []byte(v))
+	}
+
+	for _, v := range names {
+		key, _ := byteutils.FromHex(v)
+		merkleProof, err := tr.Prove(key)
+		assert.Nil(t, err)
+		value, err := VerifyProof(tr.RootHash(), key, marshalProof(t, merkleProof))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(v), value)
+	}
+
+	key, _ := byteutils.FromHex(names[0])
+	merkleProof, _ := tr.Prove(key)
+	proof := marshalProof(t, merkleProof)
+
+	other, _ := byteutils.FromHex(names[1])
+	_, err := VerifyProof(tr.RootHash(), other, proof)
+	assert.NotNil(t, err)
+
+	_, err = VerifyProof([]byte("wrong root"), key, proof)
+	assert.Equal(t, ErrInvalidProof, err)
+
+	_, err = VerifyProof(tr.RootHash(), key, proof[:len(proof)-1])
+	assert.Equal(t, ErrInvalidProof, err)
+}
+
+func TestVerifyAccount(t *testing.T) {
+	s, _ := storage.NewMemoryStorage()
+	vars, _ := trie.NewTrie(nil, s)
+	varKey := trie.HashDomains("", "totalSupply")
+	vars.Put(varKey, []byte("1000"))
+
+	address := []byte("012345678901234567890000")
+	account := &corepb.Account{Address: address, Nonce: 1, VarsHash: vars.RootHash()}
+	accountBytes, _ := proto.Marshal(account)
+	state, _ := trie.NewTrie(nil, s)
+	state.Put(address, accountBytes)
+	state.Put([]byte("112345678901234567890000"), accountBytes)
+
+	merkleProof, err := state.Prove(address)
+	assert.Nil(t, err)
+	accountProof := marshalProof(t, merkleProof)
+	got, err := VerifyAccount(state.RootHash(), address, accountProof)
+	assert.Nil(t, err)
+	assert.Equal(t, account.Nonce, got.Nonce)
+	assert.Equal(t, account.VarsHash, got.VarsHash)
+
+	merkleProof, err = vars.Prove(varKey)
+	assert.Nil(t, err)
+	value, err := VerifyStorage(state.RootHash(), address, varKey, accountProof, marshalProof(t, merkleProof))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("1000"), value)
+}
+
+func TestVerifyBlock(t *testing.T) {
+	block := &corepb.Block{Header: &corepb.BlockHeader{Hash: []byte("hash"), DposContext: &corepb.DposContext{}}}
+	assert.Equal(t, ErrInvalidBlockHash, VerifyBlock(block, []byte("hash")))
+	assert.Equal(t, ErrInvalidBlockHash, VerifyBlock(&corepb.Block{}, []byte("hash")))
+}
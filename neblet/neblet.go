@@ -3,6 +3,7 @@ package neblet
 import (
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/nebulasio/go-nebulas/account"
 	"github.com/nebulasio/go-nebulas/consensus"
@@ -99,7 +100,19 @@ func (n *Neblet) Setup() error {
 	gasPrice := util.NewUint128FromString(n.config.Chain.GasPrice)
 	gasLimit := util.NewUint128FromString(n.config.Chain.GasLimit)
 	n.blockChain.TransactionPool().SetGasConfig(gasPrice, gasLimit)
+	n.blockChain.TransactionPool().SetPriceBump(n.config.Chain.TxPoolPriceBump)
+	n.blockChain.TransactionPool().SetLimits(int(n.config.Chain.TxPoolSize), int(n.config.Chain.TxPoolAccountSlots),
+		time.Duration(n.config.Chain.TxPoolLifetime)*time.Second)
+	if err = n.blockChain.TransactionPool().EnableJournal(time.Duration(n.config.Chain.TxPoolJournalInterval) * time.Second); err != nil {
+		return err
+	}
 	n.blockChain.TransactionPool().RegisterInNetwork(n.netService)
+	n.blockChain.GasPriceOracle().SetConfig(int(n.config.Chain.GasPriceOracleBlocks), int(n.config.Chain.GasPriceOraclePercentile))
+	if n.config.Chain.StatePrune {
+		if err = n.blockChain.EnableStatePruning(n.config.Chain.StatePruneKeepBlocks, n.config.Chain.StatePruneCheckpointInterval); err != nil {
+			return err
+		}
+	}
 
 	n.consensus, err = dpos.NewDpos(n)
 	if err != nil {
@@ -109,6 +122,9 @@ func (n *Neblet) Setup() error {
 
 	// start sync service
 	n.syncManager = nsync.NewManager(n.blockChain, n.consensus, n.netService)
+	if n.config.Chain.FastSync {
+		n.syncManager.EnableFastSync()
+	}
 
 	n.apiServer = rpc.NewAPIServer(n)
 	return nil
@@ -183,6 +183,28 @@ type ChainConfig struct {
 	GasLimit string `protobuf:"bytes,25,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 	// Supported signature cipher list. ["ECC_SECP256K1"]
 	SignatureCiphers []string `protobuf:"bytes,26,rep,name=signature_ciphers,json=signatureCiphers" json:"signature_ciphers,omitempty"`
+	// Prune the stale states, otherwise run in archive mode which keeps all history states.
+	StatePrune bool `protobuf:"varint,27,opt,name=state_prune,json=statePrune,proto3" json:"state_prune,omitempty"`
+	// Number of latest blocks whose states are kept when pruning, default is 128.
+	StatePruneKeepBlocks uint64 `protobuf:"varint,28,opt,name=state_prune_keep_blocks,json=statePruneKeepBlocks,proto3" json:"state_prune_keep_blocks,omitempty"`
+	// States of blocks at multiples of the interval are kept as checkpoints when pruning, default is 1024.
+	StatePruneCheckpointInterval uint64 `protobuf:"varint,29,opt,name=state_prune_checkpoint_interval,json=statePruneCheckpointInterval,proto3" json:"state_prune_checkpoint_interval,omitempty"`
+	// Download the states of a recent pivot block from peers before syncing blocks, when the chain is empty.
+	FastSync bool `protobuf:"varint,30,opt,name=fast_sync,json=fastSync,proto3" json:"fast_sync,omitempty"`
+	// Percentage by which a tx must raise the gasPrice to replace a pooled tx with the same sender and nonce, default is 10.
+	TxPoolPriceBump uint32 `protobuf:"varint,31,opt,name=tx_pool_price_bump,json=txPoolPriceBump,proto3" json:"tx_pool_price_bump,omitempty"`
+	// Maximum count of txs in the tx pool, default is 4096.
+	TxPoolSize uint32 `protobuf:"varint,32,opt,name=tx_pool_size,json=txPoolSize,proto3" json:"tx_pool_size,omitempty"`
+	// Count of txs a sender can keep in a full tx pool, txs of senders over it are evicted first, default is 64.
+	TxPoolAccountSlots uint32 `protobuf:"varint,33,opt,name=tx_pool_account_slots,json=txPoolAccountSlots,proto3" json:"tx_pool_account_slots,omitempty"`
+	// Maximum seconds a tx stays in the tx pool, default is 10800.
+	TxPoolLifetime uint64 `protobuf:"varint,34,opt,name=tx_pool_lifetime,json=txPoolLifetime,proto3" json:"tx_pool_lifetime,omitempty"`
+	// Seconds between rotations of the journal of locally submitted txs, default is 3600.
+	TxPoolJournalInterval uint64 `protobuf:"varint,35,opt,name=tx_pool_journal_interval,json=txPoolJournalInterval,proto3" json:"tx_pool_journal_interval,omitempty"`
+	// Number of recent blocks sampled by the gasPrice oracle, default is 20.
+	GasPriceOracleBlocks uint32 `protobuf:"varint,36,opt,name=gas_price_oracle_blocks,json=gasPriceOracleBlocks,proto3" json:"gas_price_oracle_blocks,omitempty"`
+	// Percentile of the gasPrices in sampled blocks suggested by the oracle, default is 60.
+	GasPriceOraclePercentile uint32 `protobuf:"varint,37,opt,name=gas_price_oracle_percentile,json=gasPriceOraclePercentile,proto3" json:"gas_price_oracle_percentile,omitempty"`
 }
 
 func (m *ChainConfig) Reset()                    { *m = ChainConfig{} }
@@ -260,6 +282,83 @@ func (m *ChainConfig) GetSignatureCiphers() []string {
 	return nil
 }
 
+func (m *ChainConfig) GetStatePrune() bool {
+	if m != nil {
+		return m.StatePrune
+	}
+	return false
+}
+
+func (m *ChainConfig) GetStatePruneKeepBlocks() uint64 {
+	if m != nil {
+		return m.StatePruneKeepBlocks
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetStatePruneCheckpointInterval() uint64 {
+	if m != nil {
+		return m.StatePruneCheckpointInterval
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetFastSync() bool {
+	if m != nil {
+		return m.FastSync
+	}
+	return false
+}
+
+func (m *ChainConfig) GetTxPoolPriceBump() uint32 {
+	if m != nil {
+		return m.TxPoolPriceBump
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetTxPoolSize() uint32 {
+	if m != nil {
+		return m.TxPoolSize
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetTxPoolAccountSlots() uint32 {
+	if m != nil {
+		return m.TxPoolAccountSlots
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetTxPoolLifetime() uint64 {
+	if m != nil {
+		return m.TxPoolLifetime
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetTxPoolJournalInterval() uint64 {
+	if m != nil {
+		return m.TxPoolJournalInterval
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetGasPriceOracleBlocks() uint32 {
+	if m != nil {
+		return m.GasPriceOracleBlocks
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetGasPriceOraclePercentile() uint32 {
+	if m != nil {
+		return m.GasPriceOraclePercentile
+	}
+	return 0
+}
+
 type RPCConfig struct {
 	// RPC listen addresses.
 	RpcListen []string `protobuf:"bytes,1,rep,name=rpc_listen,json=rpcListen" json:"rpc_listen,omitempty"`
@@ -446,49 +545,65 @@ func init() {
 func init() { proto.RegisterFile("config.proto", fileDescriptorConfig) }
 
 var fileDescriptorConfig = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xeb, 0x6a, 0xf3, 0x46,
-	0x10, 0xad, 0xed, 0x5c, 0xa4, 0x71, 0xe2, 0x24, 0x9b, 0xdb, 0x26, 0xa1, 0x4d, 0x10, 0x04, 0x0c,
-	0x01, 0x43, 0xd3, 0xfe, 0xed, 0x8f, 0x62, 0x28, 0x84, 0x24, 0x25, 0xa8, 0x0f, 0x20, 0x74, 0x19,
-	0xcb, 0x4b, 0xd6, 0xd2, 0xb2, 0xbb, 0xce, 0x85, 0x3e, 0x43, 0x1f, 0xa5, 0x8f, 0xf4, 0xbd, 0xcb,
-	0xc7, 0xac, 0x56, 0x72, 0x6c, 0xbe, 0x7f, 0x9a, 0x73, 0xce, 0xce, 0xee, 0x9c, 0x99, 0x11, 0xec,
-	0xe5, 0x75, 0x35, 0x13, 0xe5, 0x44, 0xe9, 0xda, 0xd6, 0x2c, 0xa8, 0x30, 0x93, 0x68, 0x55, 0x16,
-	0xfd, 0xd7, 0x87, 0x9d, 0xa9, 0xa3, 0xd8, 0xaf, 0xb0, 0x5b, 0xa1, 0x7d, 0xaf, 0xf5, 0x2b, 0xef,
-	0xdd, 0xf4, 0xc6, 0xc3, 0xfb, 0xf3, 0x49, 0x2b, 0x9b, 0xfc, 0xdd, 0x10, 0x8d, 0x32, 0x6e, 0x75,
-	0xec, 0x0e, 0xb6, 0xf3, 0x79, 0x2a, 0x2a, 0xde, 0x77, 0x07, 0x4e, 0x57, 0x07, 0xa6, 0x04, 0x7b,
-	0x79, 0xa3, 0x61, 0xb7, 0x30, 0xd0, 0x2a, 0xe7, 0x03, 0x27, 0x3d, 0x5e, 0x49, 0xe3, 0x97, 0xa9,
-	0x17, 0x12, 0x4f, 0x39, 0x8d, 0x4d, 0xad, 0xe1, 0xc5, 0x66, 0xce, 0x7f, 0x08, 0x6e, 0x73, 0x3a,
-	0x0d, 0x1b, 0xc3, 0xd6, 0x42, 0x98, 0x9c, 0xa3, 0xd3, 0x9e, 0xac, 0xb4, 0xcf, 0xc2, 0xe4, 0x5e,
-	0xea, 0x14, 0x74, 0x7b, 0xaa, 0x14, 0x9f, 0x6d, 0xde, 0xfe, 0xa7, 0x52, 0xed, 0xed, 0xa9, 0x52,
-	0xd1, 0xbf, 0xb0, 0xbf, 0x56, 0x2b, 0x63, 0xb0, 0x65, 0x10, 0x0b, 0xde, 0xbb, 0x19, 0x8c, 0xc3,
-	0xd8, 0x7d, 0xb3, 0x33, 0xd8, 0x91, 0xc2, 0x58, 0xa4, 0xba, 0x09, 0xf5, 0x11, 0xbb, 0x86, 0xa1,
-	0xd2, 0xe2, 0x2d, 0xb5, 0x98, 0xbc, 0xe2, 0xa7, 0xab, 0x34, 0x8c, 0xc1, 0x43, 0x8f, 0xf8, 0xc9,
-	0x7e, 0x06, 0xf0, 0xd6, 0x25, 0xa2, 0xe0, 0x5b, 0x37, 0xbd, 0xf1, 0x7e, 0x1c, 0x7a, 0xe4, 0xa1,
-	0x88, 0xfe, 0xef, 0xc3, 0xf0, 0x8b, 0x71, 0xec, 0x02, 0x02, 0x67, 0x1d, 0x89, 0x7b, 0x4e, 0xbc,
-	0xeb, 0xe2, 0x87, 0x82, 0x71, 0xd8, 0x2d, 0xb1, 0x42, 0x23, 0x8c, 0xf3, 0x3e, 0x8c, 0xdb, 0x90,
-	0x98, 0x22, 0xb5, 0x69, 0x21, 0x34, 0x1f, 0x36, 0x8c, 0x0f, 0xe9, 0xd9, 0xaf, 0xf8, 0x49, 0xc4,
-	0x9e, 0x23, 0x7c, 0xc4, 0x2e, 0x21, 0xc8, 0x6b, 0x51, 0x65, 0xa9, 0x41, 0x7e, 0xea, 0x98, 0x2e,
-	0x66, 0x27, 0xb0, 0xbd, 0x10, 0x15, 0x6a, 0x7e, 0xe6, 0x88, 0x26, 0x60, 0xbf, 0x00, 0xa8, 0xd4,
-	0x18, 0x35, 0xd7, 0x74, 0xe6, 0xdc, 0xd7, 0xd9, 0x21, 0xec, 0x0a, 0xc2, 0x32, 0x35, 0x89, 0xd2,
-	0x22, 0x47, 0xce, 0x9b, 0x94, 0x65, 0x6a, 0x5e, 0x28, 0x6e, 0x49, 0x29, 0x16, 0xc2, 0xf2, 0x8b,
-	0x8e, 0x7c, 0xa2, 0x98, 0xdd, 0xc1, 0x91, 0x11, 0x65, 0x95, 0xda, 0xa5, 0xc6, 0x24, 0x17, 0x6a,
-	0x8e, 0xda, 0xf0, 0x4b, 0xe7, 0xf2, 0x61, 0x47, 0x4c, 0x1b, 0x3c, 0x92, 0x10, 0x76, 0xc3, 0x43,
-	0xde, 0x6a, 0x95, 0x27, 0xbe, 0x31, 0x4d, 0xbb, 0x42, 0xad, 0xf2, 0xa7, 0xae, 0x37, 0x73, 0x6b,
-	0x55, 0xb2, 0xd6, 0x38, 0x20, 0x68, 0x43, 0xb0, 0xa8, 0x8b, 0xa5, 0x44, 0x3e, 0x58, 0x09, 0x9e,
-	0x1d, 0x12, 0x19, 0x08, 0xbb, 0x61, 0xa1, 0x22, 0x64, 0x5d, 0x26, 0x12, 0xdf, 0x50, 0xba, 0xde,
-	0x84, 0x71, 0x20, 0xeb, 0xf2, 0x89, 0x62, 0xea, 0x1b, 0x91, 0x33, 0x21, 0xb1, 0xed, 0x8e, 0xac,
-	0xcb, 0xbf, 0x84, 0x44, 0x36, 0x81, 0x63, 0xac, 0xd2, 0x4c, 0x62, 0x92, 0xeb, 0xd4, 0xcc, 0x13,
-	0x8d, 0xaa, 0xd6, 0xd6, 0x8d, 0x4a, 0x10, 0x1f, 0x35, 0xd4, 0x94, 0x98, 0xd8, 0x11, 0xd1, 0x23,
-	0xc0, 0x6a, 0x94, 0xd9, 0x1f, 0x70, 0x55, 0xe0, 0x2c, 0x5d, 0x4a, 0x4b, 0x03, 0x66, 0x6c, 0xad,
-	0xd1, 0xdd, 0x42, 0x4e, 0xa1, 0xf6, 0xef, 0xe0, 0x5e, 0xf2, 0xe8, 0x15, 0x74, 0xef, 0x94, 0xf8,
-	0xe8, 0x5b, 0x0f, 0x86, 0x5f, 0x96, 0x88, 0xdd, 0xc2, 0xc8, 0x3f, 0x66, 0x81, 0x56, 0x8b, 0xdc,
-	0xb8, 0x0c, 0x41, 0xbc, 0xdf, 0xa0, 0xcf, 0x0d, 0xc8, 0x5e, 0xe0, 0xb0, 0x79, 0xa6, 0xa8, 0xca,
-	0xd6, 0x1e, 0xf2, 0x6f, 0x74, 0x7f, 0xfb, 0xc3, 0xe5, 0x9c, 0xc4, 0xad, 0xba, 0x71, 0x2e, 0x3e,
-	0xd0, 0xeb, 0x00, 0xfb, 0x1d, 0x02, 0x51, 0xcd, 0xe4, 0xf2, 0xa3, 0xc8, 0xdc, 0x90, 0x0e, 0xef,
-	0xf9, 0x2a, 0xd3, 0x83, 0x67, 0xfc, 0x5a, 0x76, 0xca, 0xe8, 0x1a, 0x0e, 0x36, 0x32, 0xb3, 0x3d,
-	0x08, 0x5a, 0xf9, 0xe1, 0x4f, 0xd1, 0x07, 0x8c, 0xd6, 0x0f, 0xd3, 0xf6, 0xce, 0x6b, 0x63, 0xbd,
-	0x33, 0xee, 0x9b, 0x30, 0xe7, 0x79, 0xdf, 0x6d, 0x94, 0xfb, 0x66, 0x23, 0xe8, 0x17, 0x99, 0x5f,
-	0xd8, 0x7e, 0x91, 0x91, 0x66, 0x69, 0x50, 0xbb, 0x15, 0x0d, 0x63, 0xf7, 0x4d, 0x6b, 0x42, 0x23,
-	0xfe, 0x5e, 0xeb, 0x82, 0x6f, 0x37, 0x1d, 0x6f, 0xe3, 0x6c, 0xc7, 0xfd, 0x57, 0x7f, 0xfb, 0x1e,
-	0x00, 0x00, 0xff, 0xff, 0xc9, 0xd3, 0x1c, 0x31, 0x67, 0x05, 0x00, 0x00,
+	// 950 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x95, 0xdb, 0x6e, 0x1b, 0x37,
+	0x10, 0x86, 0x2b, 0x1f, 0xa5, 0x91, 0x4f, 0x61, 0xec, 0x98, 0x89, 0x93, 0x58, 0x55, 0x6b, 0x40,
+	0x40, 0x00, 0x03, 0x71, 0x1b, 0xf4, 0xaa, 0x17, 0x89, 0xd0, 0x02, 0xae, 0xed, 0x56, 0x58, 0x3f,
+	0xc0, 0x62, 0xb5, 0x3b, 0x92, 0x58, 0x51, 0x4b, 0x82, 0xe4, 0x3a, 0x76, 0xfa, 0x0c, 0x7d, 0xab,
+	0xbe, 0x46, 0xdf, 0xa5, 0xe0, 0x90, 0xbb, 0xb2, 0xdc, 0xdc, 0xed, 0xcc, 0xff, 0x0d, 0x0f, 0xbf,
+	0x38, 0x23, 0xd8, 0xc9, 0x55, 0x39, 0x11, 0xd3, 0x73, 0x6d, 0x94, 0x53, 0xac, 0x5d, 0xe2, 0x58,
+	0xa2, 0xd3, 0xe3, 0xfe, 0xdf, 0x6b, 0xb0, 0x35, 0x24, 0x89, 0xbd, 0x87, 0xed, 0x12, 0xdd, 0x67,
+	0x65, 0xe6, 0xbc, 0xd5, 0x6b, 0x0d, 0xba, 0x17, 0xc7, 0xe7, 0x35, 0x76, 0xfe, 0x7b, 0x10, 0x02,
+	0x99, 0xd4, 0x1c, 0x7b, 0x07, 0x9b, 0xf9, 0x2c, 0x13, 0x25, 0x5f, 0xa3, 0x82, 0xa3, 0x65, 0xc1,
+	0xd0, 0xa7, 0x23, 0x1e, 0x18, 0x76, 0x06, 0xeb, 0x46, 0xe7, 0x7c, 0x9d, 0xd0, 0xe7, 0x4b, 0x34,
+	0x19, 0x0d, 0x23, 0xe8, 0x75, 0xbf, 0xa6, 0x75, 0x99, 0xb3, 0xbc, 0x78, 0xba, 0xe6, 0xad, 0x4f,
+	0xd7, 0x6b, 0x12, 0xc3, 0x06, 0xb0, 0xb1, 0x10, 0x36, 0xe7, 0x48, 0xec, 0xe1, 0x92, 0xbd, 0x11,
+	0x36, 0x8f, 0x28, 0x11, 0x7e, 0xf7, 0x4c, 0x6b, 0x3e, 0x79, 0xba, 0xfb, 0x47, 0xad, 0xeb, 0xdd,
+	0x33, 0xad, 0xfb, 0x7f, 0xc1, 0xee, 0xca, 0x5d, 0x19, 0x83, 0x0d, 0x8b, 0x58, 0xf0, 0x56, 0x6f,
+	0x7d, 0xd0, 0x49, 0xe8, 0x9b, 0xbd, 0x80, 0x2d, 0x29, 0xac, 0x43, 0x7f, 0x6f, 0x9f, 0x8d, 0x11,
+	0x3b, 0x85, 0xae, 0x36, 0xe2, 0x2e, 0x73, 0x98, 0xce, 0xf1, 0x81, 0x6e, 0xda, 0x49, 0x20, 0xa6,
+	0xae, 0xf0, 0x81, 0xbd, 0x01, 0x88, 0xd6, 0xa5, 0xa2, 0xe0, 0x1b, 0xbd, 0xd6, 0x60, 0x37, 0xe9,
+	0xc4, 0xcc, 0x65, 0xd1, 0xff, 0x67, 0x0b, 0xba, 0x8f, 0x8c, 0x63, 0x2f, 0xa1, 0x4d, 0xd6, 0x79,
+	0xb8, 0x45, 0xf0, 0x36, 0xc5, 0x97, 0x05, 0xe3, 0xb0, 0x3d, 0xc5, 0x12, 0xad, 0xb0, 0xe4, 0x7d,
+	0x27, 0xa9, 0x43, 0xaf, 0x14, 0x99, 0xcb, 0x0a, 0x61, 0x78, 0x37, 0x28, 0x31, 0xf4, 0xc7, 0x9e,
+	0xe3, 0x83, 0x17, 0x76, 0x48, 0x88, 0x11, 0x7b, 0x05, 0xed, 0x5c, 0x89, 0x72, 0x9c, 0x59, 0xe4,
+	0x47, 0xa4, 0x34, 0x31, 0x3b, 0x84, 0xcd, 0x85, 0x28, 0xd1, 0xf0, 0x17, 0x24, 0x84, 0x80, 0xbd,
+	0x05, 0xd0, 0x99, 0xb5, 0x7a, 0x66, 0x7c, 0xcd, 0x71, 0xbc, 0x67, 0x93, 0x61, 0x27, 0xd0, 0x99,
+	0x66, 0x36, 0xd5, 0x46, 0xe4, 0xc8, 0x79, 0x58, 0x72, 0x9a, 0xd9, 0x91, 0x8f, 0x6b, 0x51, 0x8a,
+	0x85, 0x70, 0xfc, 0x65, 0x23, 0x5e, 0xfb, 0x98, 0xbd, 0x83, 0x67, 0x56, 0x4c, 0xcb, 0xcc, 0x55,
+	0x06, 0xd3, 0x5c, 0xe8, 0x19, 0x1a, 0xcb, 0x5f, 0x91, 0xcb, 0x07, 0x8d, 0x30, 0x0c, 0x79, 0xef,
+	0xb7, 0x7f, 0x06, 0x98, 0x6a, 0x53, 0x95, 0xc8, 0x4f, 0x7a, 0xad, 0x41, 0x3b, 0x01, 0x4a, 0x8d,
+	0x7c, 0x86, 0x7d, 0x80, 0xe3, 0x47, 0x40, 0x3a, 0x47, 0xd4, 0xe9, 0x58, 0xaa, 0x7c, 0x6e, 0xf9,
+	0xeb, 0x5e, 0x6b, 0xb0, 0x91, 0x1c, 0x2e, 0xe1, 0x2b, 0x44, 0xfd, 0x89, 0x34, 0xf6, 0x0b, 0x9c,
+	0x3e, 0x2e, 0xcb, 0x67, 0x98, 0xcf, 0xb5, 0x12, 0xa5, 0x4b, 0x45, 0xe9, 0xd0, 0xdc, 0x65, 0x92,
+	0xbf, 0xa1, 0xf2, 0xd7, 0xcb, 0xf2, 0x61, 0x03, 0x5d, 0x46, 0xc6, 0x5f, 0x74, 0x92, 0x59, 0x97,
+	0xda, 0x87, 0x32, 0xe7, 0x6f, 0xe9, 0x70, 0x6d, 0x9f, 0xb8, 0x7d, 0x28, 0xfd, 0x33, 0x67, 0xee,
+	0x3e, 0xd5, 0x4a, 0xc9, 0x60, 0x53, 0x3a, 0xae, 0x16, 0x9a, 0x9f, 0xd2, 0xaf, 0xbc, 0xef, 0xee,
+	0x47, 0x4a, 0x49, 0xb2, 0xeb, 0x53, 0xb5, 0xd0, 0xac, 0x07, 0x3b, 0x35, 0x6c, 0xc5, 0x17, 0xe4,
+	0x3d, 0xc2, 0x20, 0x60, 0xb7, 0xe2, 0x0b, 0xb2, 0xf7, 0x70, 0x54, 0x13, 0x59, 0x9e, 0xab, 0xaa,
+	0x74, 0xa9, 0x95, 0xca, 0x59, 0xfe, 0x2d, 0xa1, 0x2c, 0xa0, 0x1f, 0x83, 0x74, 0xeb, 0x15, 0x36,
+	0x80, 0x83, 0xba, 0x44, 0x8a, 0x09, 0x3a, 0xb1, 0x40, 0xde, 0xa7, 0x6b, 0xed, 0x05, 0xfa, 0x3a,
+	0x66, 0xd9, 0x4f, 0xc0, 0x6b, 0xf2, 0x4f, 0x55, 0x99, 0x32, 0x93, 0x4b, 0x23, 0xbe, 0xa3, 0x8a,
+	0xa3, 0x50, 0xf1, 0x5b, 0x50, 0x1b, 0x07, 0x3e, 0xc0, 0x71, 0xf3, 0x0e, 0x52, 0x65, 0xb2, 0x5c,
+	0x62, 0xed, 0xff, 0xf7, 0x74, 0xae, 0xc3, 0xfa, 0x55, 0xfc, 0x41, 0x62, 0xf4, 0xff, 0x67, 0x38,
+	0xf9, 0x5f, 0x99, 0x46, 0x93, 0x63, 0xe9, 0x84, 0x44, 0x7e, 0x46, 0xa5, 0x7c, 0xb5, 0x74, 0xd4,
+	0xe8, 0x7d, 0x09, 0x9d, 0x66, 0xa6, 0xf8, 0x96, 0x33, 0x3a, 0x4f, 0x63, 0xbf, 0x86, 0x2e, 0xee,
+	0x18, 0x9d, 0x5f, 0x37, 0x2d, 0x3b, 0x73, 0x4e, 0xa7, 0x2b, 0xfd, 0x0c, 0x3e, 0xf5, 0x04, 0x58,
+	0xa8, 0xa2, 0x92, 0xc8, 0xd7, 0x97, 0xc0, 0x0d, 0x65, 0xfa, 0x16, 0x3a, 0xcd, 0x0c, 0xf1, 0x3f,
+	0xb9, 0x54, 0xd3, 0x54, 0xe2, 0x1d, 0x4a, 0x6a, 0xd9, 0x4e, 0xd2, 0x96, 0x6a, 0x7a, 0xed, 0x63,
+	0xdf, 0xce, 0x5e, 0x9c, 0xf8, 0x3b, 0xc4, 0xa6, 0x95, 0x6a, 0xfa, 0xab, 0x90, 0xc8, 0xce, 0xe1,
+	0x39, 0x96, 0xd9, 0x58, 0x62, 0x9a, 0x9b, 0xcc, 0xce, 0x52, 0x83, 0x5a, 0x19, 0x47, 0x13, 0xa4,
+	0x9d, 0x3c, 0x0b, 0xd2, 0xd0, 0x2b, 0x09, 0x09, 0xfd, 0x2b, 0x80, 0xe5, 0x84, 0xf3, 0x7e, 0x15,
+	0x38, 0xc9, 0x2a, 0xe9, 0xfc, 0xdc, 0xb1, 0x4e, 0x19, 0xa4, 0x5d, 0x7c, 0x03, 0xa1, 0x89, 0xe7,
+	0xe0, 0x11, 0xb9, 0x8a, 0x84, 0xdf, 0x77, 0xe8, 0xf5, 0xfe, 0xbf, 0x2d, 0xe8, 0x3e, 0x9a, 0xad,
+	0xec, 0x0c, 0xf6, 0xe2, 0x61, 0x16, 0xe8, 0x8c, 0xc8, 0x2d, 0xad, 0xd0, 0x4e, 0x76, 0x43, 0xf6,
+	0x26, 0x24, 0xd9, 0x08, 0x0e, 0xc2, 0x31, 0x45, 0x39, 0xad, 0xed, 0xf1, 0xfe, 0xed, 0x5d, 0x9c,
+	0x7d, 0x75, 0x66, 0x9f, 0x27, 0x35, 0x1d, 0x9c, 0x4b, 0xf6, 0xcd, 0x6a, 0x82, 0xfd, 0x08, 0x6d,
+	0x51, 0x4e, 0x64, 0x75, 0x5f, 0x8c, 0x69, 0x76, 0x75, 0x2f, 0xf8, 0x72, 0xa5, 0xcb, 0xa8, 0xc4,
+	0x69, 0xdd, 0x90, 0xfd, 0x53, 0xd8, 0x7f, 0xb2, 0x32, 0xdb, 0x81, 0x76, 0x8d, 0x1f, 0x7c, 0xd3,
+	0xbf, 0x87, 0xbd, 0xd5, 0x62, 0x3f, 0xd4, 0x67, 0xca, 0xba, 0xe8, 0x0c, 0x7d, 0xfb, 0x1c, 0x79,
+	0xbe, 0x46, 0xaf, 0x8b, 0xbe, 0xd9, 0x1e, 0xac, 0x15, 0xe3, 0x38, 0xc7, 0xd7, 0x8a, 0xb1, 0x67,
+	0x2a, 0x8b, 0x86, 0x26, 0x77, 0x27, 0xa1, 0x6f, 0x3f, 0x3d, 0xfd, 0xe4, 0xfb, 0xac, 0x4c, 0xc1,
+	0x37, 0xc3, 0x2f, 0x5e, 0xc7, 0xe3, 0x2d, 0xfa, 0xbb, 0xfd, 0xe1, 0xbf, 0x01, 0x00, 0x37, 0x92,
+	0x65, 0xf6, 0x7e, 0x07, 0x00, 0x00,
 }
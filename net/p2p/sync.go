@@ -82,6 +82,23 @@ func (ns *NetService) Sync(tail net.Serializable) error {
 	return nil
 }
 
+// SyncPeers return the keys of the connected peers to sync with.
+func (ns *NetService) SyncPeers() []string {
+	node := ns.node
+	var peers []string
+	for _, nodeID := range node.routeTable.ListPeers() {
+		addrs := node.peerstore.PeerInfo(nodeID).Addrs
+		if len(addrs) == 0 || node.host.Addrs()[0] == addrs[0] {
+			continue
+		}
+		key := nodeID.Pretty()
+		if _, ok := node.stream.Load(key); ok {
+			peers = append(peers, key)
+		}
+	}
+	return peers
+}
+
 // SendSyncReply send sync reply message to remote peer
 func (ns *NetService) SendSyncReply(key string, blocks net.Serializable) {
 
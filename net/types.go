@@ -24,6 +24,11 @@ import "github.com/gogo/protobuf/proto"
 const (
 	MessageTypeSyncBlock = "syncblock"
 	MessageTypeSyncReply = "syncreply"
+
+	MessageTypeSyncPivot      = "syncpivot"
+	MessageTypeSyncPivotReply = "pivotreply"
+	MessageTypeSyncNodes      = "syncnodes"
+	MessageTypeSyncNodesReply = "nodesreply"
 )
 
 // MessageType a string for message type.
@@ -24,7 +24,8 @@ import (
 	"encoding/json"
 	"unsafe"
 
-	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -35,6 +36,9 @@ func GetTxByHashFunc(handler unsafe.Pointer, hash *C.char) *C.char {
 	if engine == nil || engine.ctx.block == nil {
 		return nil
 	}
+	if engine.chargeGas(GetTxByHashGas) != nil {
+		return nil
+	}
 	tx, err := engine.ctx.SerializeTxByHash([]byte(C.GoString(hash)))
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -55,6 +59,9 @@ func GetAccountStateFunc(handler unsafe.Pointer, address *C.char) *C.char {
 	if engine == nil || engine.ctx.block == nil {
 		return nil
 	}
+	if engine.chargeGas(GetAccountStateGas) != nil {
+		return nil
+	}
 	addr := C.GoString(address)
 	valid := engine.ctx.block.VerifyAddress(addr)
 	if !valid {
@@ -66,7 +73,7 @@ func GetAccountStateFunc(handler unsafe.Pointer, address *C.char) *C.char {
 		return nil
 	}
 
-	acc := engine.ctx.state.GetOrCreateUserAccount([]byte(addr))
+	acc := engine.ctx.journal.getOrCreateUserAccount(engine.ctx.state, []byte(addr))
 	state := &AccountState{
 		Nonce:   acc.Nonce(),
 		Balance: acc.Balance().String(),
@@ -82,6 +89,9 @@ func TransferFunc(handler unsafe.Pointer, to *C.char, v *C.char) int {
 	if engine == nil || engine.ctx.block == nil {
 		return 1
 	}
+	if engine.chargeGas(TransferGas) != nil {
+		return 1
+	}
 
 	addr := C.GoString(to)
 	valid := engine.ctx.block.VerifyAddress(addr)
@@ -94,13 +104,31 @@ func TransferFunc(handler unsafe.Pointer, to *C.char, v *C.char) int {
 		return 1
 	}
 
-	toAcc := engine.ctx.state.GetOrCreateUserAccount([]byte(addr))
+	amount, err := parseTransferValue(C.GoString(v))
+	if err != nil {
+		log.WithFields(log.Fields{
+			"func":    "nvm.TransferFunc",
+			"handler": uint64(uintptr(handler)),
+			"value":   C.GoString(v),
+			"err":     err,
+		}).Debug("TransferFunc parse value failed.")
+		return 1
+	}
 
-	var (
-		amount *util.Uint128
-		err    error
-	)
-	amount = util.NewUint128FromString(C.GoString(v))
+	toAddr, err := byteutils.FromHex(addr)
+	if err != nil {
+		return 1
+	}
+	toAcc := engine.ctx.journal.getOrCreateUserAccount(engine.ctx.state, toAddr)
+	if err := state.CheckReceiver(toAcc); err != nil {
+		log.WithFields(log.Fields{
+			"func":    "nvm.TransferFunc",
+			"handler": uint64(uintptr(handler)),
+			"key":     C.GoString(to),
+			"err":     err,
+		}).Debug("TransferFunc to destructed contract.")
+		return 1
+	}
 
 	// update balance
 	err = engine.ctx.contract.SubBalance(amount)
@@ -113,8 +141,10 @@ func TransferFunc(handler unsafe.Pointer, to *C.char, v *C.char) int {
 		}).Error("TransferFunc SubBalance failed.")
 		return 1
 	}
+	engine.ctx.journal.recordSubBalance(engine.ctx.contract, amount)
 
 	toAcc.AddBalance(amount)
+	engine.ctx.journal.recordAddBalance(toAcc, amount)
 	return 0
 }
 
@@ -125,9 +155,49 @@ func VerifyAddressFunc(handler unsafe.Pointer, address *C.char) int {
 	if engine == nil || engine.ctx.block == nil {
 		return 0
 	}
+	if engine.chargeGas(VerifyAddressGas) != nil {
+		return 0
+	}
 
 	if engine.ctx.block.VerifyAddress(C.GoString(address)) {
 		return 1
 	}
 	return 0
 }
+
+// CallFunc call a function of another contract
+//export CallFunc
+func CallFunc(handler unsafe.Pointer, address *C.char, function *C.char, args *C.char, v *C.char, gasCnt *C.size_t) *C.char {
+	engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
+	if engine == nil || engine.ctx.block == nil {
+		return nil
+	}
+	if engine.chargeGas(CallGas) != nil {
+		return nil
+	}
+
+	value, err := parseTransferValue(C.GoString(v))
+	if err != nil {
+		log.WithFields(log.Fields{
+			"func":    "nvm.CallFunc",
+			"handler": uint64(uintptr(handler)),
+			"value":   C.GoString(v),
+			"err":     err,
+		}).Debug("CallFunc parse value failed.")
+		return nil
+	}
+	result, instructions, err := engine.call(C.GoString(address), C.GoString(function), C.GoString(args), value)
+	*gasCnt = C.size_t(instructions)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"func":     "nvm.CallFunc",
+			"handler":  uint64(uintptr(handler)),
+			"address":  C.GoString(address),
+			"function": C.GoString(function),
+			"depth":    engine.ctx.depth,
+			"err":      err,
+		}).Debug("CallFunc call contract failed.")
+		return nil
+	}
+	return C.CString(result)
+}
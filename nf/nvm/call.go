@@ -0,0 +1,202 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// MaxCallDepth the max depth of the nested contract calls.
+const MaxCallDepth = 8
+
+// callJournal record how to undo the changes made in the nested contract calls,
+// the changes of a failed call are reverted to the mark taken before it. Events
+// of the nested calls are buffered until the outermost call succeeds.
+type callJournal struct {
+	undos  []func()
+	events []*journalEvent
+}
+
+type journalEvent struct {
+	txHash byteutils.Hash
+	topic  string
+	data   string
+}
+
+func (j *callJournal) record(undo func()) {
+	if j == nil {
+		return
+	}
+	j.undos = append(j.undos, undo)
+}
+
+func (j *callJournal) recordStorage(acc state.Account, key []byte) {
+	if j == nil {
+		return
+	}
+	old, err := acc.Get(key)
+	if err != nil {
+		j.record(func() { acc.Del(key) })
+	} else {
+		j.record(func() { acc.Put(key, old) })
+	}
+}
+
+func (j *callJournal) recordAddBalance(acc state.Account, amount *util.Uint128) {
+	j.record(func() { acc.SubBalance(amount) })
+}
+
+func (j *callJournal) recordSubBalance(acc state.Account, amount *util.Uint128) {
+	j.record(func() { acc.AddBalance(amount) })
+}
+
+func (j *callJournal) recordEvent(txHash byteutils.Hash, topic, data string) {
+	n := len(j.events)
+	j.events = append(j.events, &journalEvent{txHash, topic, data})
+	j.record(func() { j.events = j.events[:n] })
+}
+
+// getOrCreateUserAccount record the creation of the account, so that a failed
+// call leaves no empty account in the state.
+func (j *callJournal) getOrCreateUserAccount(s state.AccountState, addr []byte) state.Account {
+	if j != nil {
+		if _, err := s.GetContractAccount(addr); err == state.ErrAccountNotFound {
+			j.record(func() { s.DiscardAccount(addr) })
+		}
+	}
+	return s.GetOrCreateUserAccount(addr)
+}
+
+func (j *callJournal) flushEvents(block Block) error {
+	for _, event := range j.events {
+		if err := block.RecordEvent(event.txHash, event.topic, event.data); err != nil {
+			return err
+		}
+	}
+	j.events = nil
+	return nil
+}
+
+func (j *callJournal) mark() int {
+	return len(j.undos)
+}
+
+func (j *callJournal) revert(mark int) {
+	for i := len(j.undos) - 1; i >= mark; i-- {
+		j.undos[i]()
+	}
+	j.undos = j.undos[:mark]
+}
+
+// parseTransferValue parse the value passed from the contract, only decimal
+// uint128 values are accepted.
+func parseTransferValue(v string) (*util.Uint128, error) {
+	value, ok := util.NewUint128().FromString(v)
+	if !ok {
+		return nil, ErrInvalidTransferValue
+	}
+	if err := value.Validate(); err != nil {
+		return nil, ErrInvalidTransferValue
+	}
+	return value, nil
+}
+
+// call run the function of the contract at address in a nested engine on the same state,
+// with value transferred from the calling contract. It returns the json-serialized result
+// and the instructions executed by the callee, which are charged to the caller.
+func (e *V8Engine) call(address, function, args string, value *util.Uint128) (string, uint64, error) {
+	ctx := e.ctx
+	if value == nil || value.Validate() != nil {
+		return "", 0, ErrInvalidTransferValue
+	}
+	if ctx.depth >= MaxCallDepth {
+		return "", 0, ErrExceedMaxCallDepth
+	}
+	if !ctx.block.VerifyAddress(address) {
+		return "", 0, ErrInvalidCallAddress
+	}
+	addr, err := byteutils.FromHex(address)
+	if err != nil {
+		return "", 0, ErrInvalidCallAddress
+	}
+	contract, err := ctx.state.GetContractAccount(addr)
+	if err != nil {
+		return "", 0, err
+	}
+	if len(contract.BirthPlace()) == 0 {
+		return "", 0, ErrInvalidCallAddress
+	}
+	source, err := ctx.block.GetContractSource(contract)
+	if err != nil {
+		return "", 0, err
+	}
+
+	journal := ctx.journal
+	if journal == nil {
+		journal = new(callJournal)
+	}
+	mark := journal.mark()
+
+	if err := ctx.contract.SubBalance(value); err != nil {
+		return "", 0, err
+	}
+	journal.recordSubBalance(ctx.contract, value)
+	contract.AddBalance(value)
+	journal.recordAddBalance(contract, value)
+
+	tx := *ctx.tx
+	tx.From = byteutils.Hex(ctx.contract.Address())
+	tx.To = address
+	tx.Value = value.String()
+	owner := journal.getOrCreateUserAccount(ctx.state, source.Owner)
+	calleeCtx := NewContext(ctx.block, &tx, owner, contract, ctx.state)
+	calleeCtx.depth = ctx.depth + 1
+	calleeCtx.journal = journal
+
+	engine := NewV8Engine(calleeCtx)
+	defer engine.Dispose()
+
+	if e.enableLimits {
+		// the callee can only use the instructions left to the caller.
+		var instructions uint64
+		if e.limitsOfExecutionInstructions > 0 {
+			used := uint64(e.v8engine.stats.count_of_executed_instructions)
+			if used >= e.limitsOfExecutionInstructions {
+				journal.revert(mark)
+				return "", 0, ErrInsufficientGas
+			}
+			instructions = e.limitsOfExecutionInstructions - used
+		}
+		engine.SetExecutionLimits(instructions, e.limitsOfTotalMemorySize)
+	}
+
+	result, err := engine.CallContract(source, function, args)
+	if err != nil {
+		journal.revert(mark)
+		return "", engine.ExecutionInstructions(), err
+	}
+	if ctx.journal == nil {
+		if err := journal.flushEvents(ctx.block); err != nil {
+			return "", engine.ExecutionInstructions(), err
+		}
+	}
+	return result, engine.ExecutionInstructions(), nil
+}
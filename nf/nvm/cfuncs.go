@@ -37,6 +37,7 @@ char *GetTxByHashFunc(void *handler, const char *hash);
 char *GetAccountStateFunc(void *handler, const char *address);
 int TransferFunc(void *handler, const char *to, const char *value);
 int VerifyAddressFunc(void *handler, const char *address);
+char *CallFunc(void *handler, const char *address, const char *function, const char *args, const char *value, size_t *gasCnt);
 
 // event.
 void EventTriggerFunc(void *handler, const char *topic, const char *data);
@@ -72,6 +73,9 @@ int TransferFunc_cgo(void *handler, const char *to, const char *value) {
 int VerifyAddressFunc_cgo(void *handler, const char *address) {
 	return VerifyAddressFunc(handler, address);
 };
+char *CallFunc_cgo(void *handler, const char *address, const char *function, const char *args, const char *value, size_t *gasCnt) {
+	return CallFunc(handler, address, function, args, value, gasCnt);
+};
 
 void EventTriggerFunc_cgo(void *handler, const char *topic, const char *data) {
 	EventTriggerFunc(handler, topic, data);
@@ -44,6 +44,18 @@ type Block interface {
 	VerifyAddress(str string) bool
 	SerializeTxByHash(hash byteutils.Hash) (proto.Message, error)
 	RecordEvent(txHash byteutils.Hash, topic, data string) error
+	GetContractSource(contract state.Account) (*ContractSource, error)
+}
+
+// ContractSource the source of a deployed contract and its owner
+type ContractSource struct {
+	Owner      byteutils.Hash
+	CodeHash   byteutils.Hash
+	Source     string
+	SourceType string
+	// Traceable is the source prepared by PrepareContractScript, empty if not prepared.
+	Traceable           string
+	TraceableLineOffset int
 }
 
 // AccountState context account state
@@ -79,6 +91,10 @@ type Context struct {
 	owner    state.Account
 	contract state.Account
 	state    state.AccountState
+
+	// depth and journal of the nested contract calls.
+	depth   int
+	journal *callJournal
 }
 
 // NewContext create a engine context
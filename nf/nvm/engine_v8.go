@@ -38,6 +38,7 @@ char *GetTxByHashFunc_cgo(void *handler, const char *hash);
 char *GetAccountStateFunc_cgo(void *handler, const char *address);
 int TransferFunc_cgo(void *handler, const char *to, const char *value);
 int VerifyAddressFunc_cgo(void *handler, const char *address);
+char *CallFunc_cgo(void *handler, const char *address, const char *function, const char *args, const char *value, size_t *gasCnt);
 
 void EventTriggerFunc_cgo(void *handler, const char *topic, const char *data);
 
@@ -60,6 +61,8 @@ import (
 const (
 	SourceTypeJavaScript = "js"
 	SourceTypeTypeScript = "ts"
+
+	contractModuleID = "contract.js"
 )
 
 // Errors
@@ -72,6 +75,9 @@ var (
 	ErrInjectTracingInstructionFailed = errors.New("inject tracing instructions failed")
 	ErrTranspileTypeScriptFailed      = errors.New("transpile TypeScript failed")
 	ErrUnsupportedSourceType          = errors.New("unsupported source type")
+	ErrExceedMaxCallDepth             = errors.New("exceed max call depth")
+	ErrInvalidCallAddress             = errors.New("invalid contract address to call")
+	ErrInvalidTransferValue           = errors.New("invalid transfer value")
 )
 
 var (
@@ -112,7 +118,7 @@ func InitV8Engine() {
 	C.InitializeStorage((C.StorageGetFunc)(unsafe.Pointer(C.StorageGetFunc_cgo)), (C.StoragePutFunc)(unsafe.Pointer(C.StoragePutFunc_cgo)), (C.StorageDelFunc)(unsafe.Pointer(C.StorageDelFunc_cgo)))
 
 	// Blockchain.
-	C.InitializeBlockchain((C.GetTxByHashFunc)(unsafe.Pointer(C.GetTxByHashFunc_cgo)), (C.GetAccountStateFunc)(unsafe.Pointer(C.GetAccountStateFunc_cgo)), (C.TransferFunc)(unsafe.Pointer(C.TransferFunc_cgo)), (C.VerifyAddressFunc)(unsafe.Pointer(C.VerifyAddressFunc_cgo)))
+	C.InitializeBlockchain((C.GetTxByHashFunc)(unsafe.Pointer(C.GetTxByHashFunc_cgo)), (C.GetAccountStateFunc)(unsafe.Pointer(C.GetAccountStateFunc_cgo)), (C.TransferFunc)(unsafe.Pointer(C.TransferFunc_cgo)), (C.VerifyAddressFunc)(unsafe.Pointer(C.VerifyAddressFunc_cgo)), (C.CallFunc)(unsafe.Pointer(C.CallFunc_cgo)))
 
 	// Event.
 	C.InitializeEvent((C.EventTriggerFunc)(unsafe.Pointer(C.EventTriggerFunc_cgo)))
@@ -214,6 +220,25 @@ func (e *V8Engine) ExecutionInstructions() uint64 {
 	return e.actualCountOfExecutionInstructions
 }
 
+// chargeGas add the gas of a native function to the executed instructions,
+// and terminate the execution if it exceeds the limits.
+func (e *V8Engine) chargeGas(gas uint64) error {
+	if !e.enableLimits {
+		return nil
+	}
+	e.v8engine.stats.count_of_executed_instructions += C.size_t(gas)
+	if e.limitsOfExecutionInstructions > 0 && uint64(e.v8engine.stats.count_of_executed_instructions) > e.limitsOfExecutionInstructions {
+		log.WithFields(log.Fields{
+			"gas":          gas,
+			"instructions": uint64(e.v8engine.stats.count_of_executed_instructions),
+			"limits":       e.limitsOfExecutionInstructions,
+		}).Debug("native function exceeds the execution limits.")
+		C.TerminateExecution(e.v8engine)
+		return ErrInsufficientGas
+	}
+	return nil
+}
+
 // TranspileTypeScript transpile typescript to javascript and return it.
 func (e *V8Engine) TranspileTypeScript(source string) (string, int, error) {
 	cSource := C.CString(source)
@@ -254,12 +279,12 @@ func (e *V8Engine) CollectTracingStats() {
 	e.actualTotalMemorySize = uint64(e.v8engine.stats.total_memory_size)
 }
 
-// RunScriptSource run js source.
-func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (err error) {
+// RunScriptSource run js source, return the result if the script completes with a string value.
+func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (result string, err error) {
 	if e.enableLimits {
 		traceableSource, traceableSourceLineOffset, err := e.InjectTracingInstructions(source)
 		if err != nil {
-			return err
+			return "", err
 		}
 		source = traceableSource
 		sourceLineOffset += traceableSourceLineOffset
@@ -268,11 +293,12 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (err err
 	cSource := C.CString(source)
 	defer C.free(unsafe.Pointer(cSource))
 	var ret C.int
+	var cResult *C.char
 
 	done := make(chan bool, 1)
 	go func() {
 		ret = C.RunScriptSource(e.v8engine, cSource, C.int(sourceLineOffset), C.uintptr_t(e.lcsHandler),
-			C.uintptr_t(e.gcsHandler))
+			C.uintptr_t(e.gcsHandler), &cResult)
 		done <- true
 	}()
 
@@ -291,6 +317,11 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (err err
 		}
 	}
 
+	if cResult != nil {
+		result = C.GoString(cResult)
+		C.free(unsafe.Pointer(cResult))
+	}
+
 	// collect tracing stats.
 	e.CollectTracingStats()
 
@@ -308,24 +339,73 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (err err
 		}
 	}
 
+	// the result is meaningless if the execution failed.
+	if err != nil {
+		result = ""
+	}
 	return
 }
 
-// Call function in a script
-func (e *V8Engine) Call(source, sourceType, function, args string) error {
+// Call function in a script, return the json-serialized result of the function.
+func (e *V8Engine) Call(source, sourceType, function, args string) (string, error) {
 	if publicFuncNameChecker.MatchString(function) == false || strings.EqualFold("init", function) == true {
-		return ErrDisallowCallPrivateFunction
+		return "", ErrDisallowCallPrivateFunction
 	}
 	return e.RunContractScript(source, sourceType, function, args)
 }
 
 // DeployAndInit a contract
-func (e *V8Engine) DeployAndInit(source, sourceType, args string) error {
+func (e *V8Engine) DeployAndInit(source, sourceType, args string) (string, error) {
 	return e.RunContractScript(source, sourceType, "init", args)
 }
 
-// RunContractScript execute script in Smart Contract's way.
-func (e *V8Engine) RunContractScript(source, sourceType, function, args string) error {
+// CallPrepared function in a script prepared by PrepareContractScript, return the json-serialized result of the function.
+func (e *V8Engine) CallPrepared(traceableSource string, sourceLineOffset int, function, args string) (string, error) {
+	if publicFuncNameChecker.MatchString(function) == false || strings.EqualFold("init", function) == true {
+		return "", ErrDisallowCallPrivateFunction
+	}
+	return e.RunPreparedContractScript(traceableSource, sourceLineOffset, function, args)
+}
+
+// CallContract call function of the contract, the source is prepared only once for each code hash.
+func (e *V8Engine) CallContract(source *ContractSource, function, args string) (string, error) {
+	if !e.enableLimits && len(source.Traceable) == 0 {
+		return e.Call(source.Source, source.SourceType, function, args)
+	}
+	script, err := e.preparedContractScript(source)
+	if err != nil {
+		return "", err
+	}
+	return e.CallPrepared(script.source, script.lineOffset, function, args)
+}
+
+// PrepareContractScript transpile the source to javascript and inject tracing instructions,
+// the result can be run by RunPreparedContractScript without being prepared again.
+func (e *V8Engine) PrepareContractScript(source, sourceType string) (string, int, error) {
+	switch sourceType {
+	case SourceTypeJavaScript:
+	case SourceTypeTypeScript:
+		jsSource, _, err := e.TranspileTypeScript(source)
+		if err != nil {
+			return "", 0, err
+		}
+		source = jsSource
+	default:
+		return "", 0, ErrUnsupportedSourceType
+	}
+	return e.InjectTracingInstructions(source)
+}
+
+// RunPreparedContractScript execute script prepared by PrepareContractScript in Smart Contract's way,
+// return the json-serialized result of the function.
+func (e *V8Engine) RunPreparedContractScript(traceableSource string, sourceLineOffset int, function, args string) (string, error) {
+	e.modules.Add(NewModule(contractModuleID, traceableSource, sourceLineOffset))
+	runnableSource, runnableLineOffset := e.runnableContractScript(function, args)
+	return e.RunScriptSource(runnableSource, runnableLineOffset)
+}
+
+// RunContractScript execute script in Smart Contract's way, return the json-serialized result of the function.
+func (e *V8Engine) RunContractScript(source, sourceType, function, args string) (string, error) {
 	var runnableSource string
 	var sourceLineOffset int
 	var err error
@@ -337,15 +417,15 @@ func (e *V8Engine) RunContractScript(source, sourceType, function, args string)
 		// transpile to javascript.
 		jsSource, _, err := e.TranspileTypeScript(source)
 		if err != nil {
-			return err
+			return "", err
 		}
 		runnableSource, sourceLineOffset, err = e.prepareRunnableContractScript(jsSource, function, args)
 	default:
-		return ErrUnsupportedSourceType
+		return "", ErrUnsupportedSourceType
 	}
 
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	return e.RunScriptSource(runnableSource, sourceLineOffset)
@@ -374,22 +454,27 @@ func (e *V8Engine) prepareRunnableContractScript(source, function, args string)
 	sourceLineOffset := 0
 
 	// add module.
-	const ModuleID string = "contract.js"
-	if err := e.AddModule(ModuleID, source, sourceLineOffset); err != nil {
+	if err := e.AddModule(contractModuleID, source, sourceLineOffset); err != nil {
 		return "", 0, err
 	}
 
+	runnableSource, runnableLineOffset := e.runnableContractScript(function, args)
+	return runnableSource, runnableLineOffset, nil
+}
+
+// runnableContractScript return the script calling function of the contract module.
+func (e *V8Engine) runnableContractScript(function, args string) (string, int) {
 	// prepare for execute.
 	blockJSON, _ := e.ctx.SerializeContextBlock()
 	txJSON, _ := e.ctx.SerializeContextTx()
 	var runnableSource string
 
 	if len(args) > 0 {
-		runnableSource = fmt.Sprintf("var __contract = require(\"%s\");\n var __instance = new __contract();\n Blockchain.blockParse(\"%s\");\n Blockchain.transactionParse(\"%s\");\n __instance[\"%s\"].apply(__instance, JSON.parse(\"%s\"));\n", ModuleID, formatArgs(string(blockJSON)), formatArgs(string(txJSON)), function, formatArgs(args))
+		runnableSource = fmt.Sprintf("var __contract = require(\"%s\");\n var __instance = new __contract();\n Blockchain.blockParse(\"%s\");\n Blockchain.transactionParse(\"%s\");\n var __result = __instance[\"%s\"].apply(__instance, JSON.parse(\"%s\"));\n JSON.stringify(__result);\n", contractModuleID, formatArgs(string(blockJSON)), formatArgs(string(txJSON)), function, formatArgs(args))
 	} else {
-		runnableSource = fmt.Sprintf("var __contract = require(\"%s\");\n var __instance = new __contract();\n Blockchain.blockParse(\"%s\");\n Blockchain.transactionParse(\"%s\");\n __instance[\"%s\"].apply(__instance);\n", ModuleID, formatArgs(string(blockJSON)), formatArgs(string(txJSON)), function)
+		runnableSource = fmt.Sprintf("var __contract = require(\"%s\");\n var __instance = new __contract();\n Blockchain.blockParse(\"%s\");\n Blockchain.transactionParse(\"%s\");\n var __result = __instance[\"%s\"].apply(__instance);\n JSON.stringify(__result);\n", contractModuleID, formatArgs(string(blockJSON)), formatArgs(string(txJSON)), function)
 	}
-	return runnableSource, 0, nil
+	return runnableSource, 0
 }
 
 func getEngineByStorageHandler(handler uint64) (*V8Engine, state.Account) {
@@ -49,6 +49,8 @@ func TestMain(m *testing.M) {
 }
 
 type mockBlock struct {
+	sources map[string]*ContractSource
+	events  []string
 }
 
 func (m *mockBlock) CoinbaseHash() byteutils.Hash {
@@ -72,6 +74,7 @@ func (m *mockBlock) VerifyAddress(str string) bool {
 }
 
 func (m *mockBlock) RecordEvent(txHash byteutils.Hash, topic, data string) error {
+	m.events = append(m.events, topic)
 	return nil
 }
 
@@ -92,6 +95,14 @@ func (m *mockBlock) SerializeTxByHash(hash byteutils.Hash) (proto.Message, error
 	return proto.Message(block), nil
 }
 
+func (m *mockBlock) GetContractSource(contract state.Account) (*ContractSource, error) {
+	source, ok := m.sources[byteutils.Hex(contract.BirthPlace())]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return source, nil
+}
+
 func testContextBlock() Block {
 	return new(mockBlock)
 }
@@ -130,12 +141,12 @@ func TestRunScriptSource(t *testing.T) {
 			context, _ := state.NewAccountState(nil, mem)
 			owner := context.GetOrCreateUserAccount([]byte("account1"))
 			owner.AddBalance(util.NewUint128FromInt(1000000000))
-			contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+			contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
 			ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
 
 			engine := NewV8Engine(ctx)
 			engine.SetExecutionLimits(900000, 10000000)
-			err = engine.RunScriptSource(string(data), 0)
+			_, err = engine.RunScriptSource(string(data), 0)
 			assert.Equal(t, tt.expectedErr, err)
 			engine.Dispose()
 		})
@@ -165,14 +176,14 @@ func TestRunScriptSourceInModule(t *testing.T) {
 			context, _ := state.NewAccountState(nil, mem)
 			owner := context.GetOrCreateUserAccount([]byte("account1"))
 			owner.AddBalance(util.NewUint128FromInt(1000000000))
-			contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+			contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
 			ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
 
 			engine := NewV8Engine(ctx)
 			engine.SetExecutionLimits(100000, 10000000)
 			engine.AddModule(tt.filepath, string(data), 0)
 			runnableSource := fmt.Sprintf("require(\"%s\");", tt.filepath)
-			err = engine.RunScriptSource(runnableSource, 0)
+			_, err = engine.RunScriptSource(runnableSource, 0)
 			assert.Equal(t, tt.expectedErr, err)
 			engine.Dispose()
 		})
@@ -206,14 +217,14 @@ func TestRunScriptSourceWithLimits(t *testing.T) {
 			context, _ := state.NewAccountState(nil, mem)
 			owner := context.GetOrCreateUserAccount([]byte("account1"))
 			owner.AddBalance(util.NewUint128FromInt(100000))
-			contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+			contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
 			ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
 
 			// direct run.
 			(func() {
 				engine := NewV8Engine(ctx)
 				engine.SetExecutionLimits(tt.limitsOfExecutionInstructions, tt.limitsOfTotalMemorySize)
-				err = engine.RunScriptSource(string(data), 0)
+				_, err = engine.RunScriptSource(string(data), 0)
 				assert.Equal(t, tt.expectedErr, err)
 				engine.Dispose()
 			})()
@@ -226,7 +237,7 @@ func TestRunScriptSourceWithLimits(t *testing.T) {
 				engine := NewV8Engine(ctx)
 				engine.SetExecutionLimits(tt.limitsOfExecutionInstructions, tt.limitsOfTotalMemorySize)
 				engine.AddModule(moduleID, string(data), 0)
-				err = engine.RunScriptSource(runnableSource, 0)
+				_, err = engine.RunScriptSource(runnableSource, 0)
 				assert.Equal(t, tt.expectedErr, err)
 				engine.Dispose()
 			})()
@@ -249,13 +260,13 @@ func TestRunScriptSourceTimeout(t *testing.T) {
 			mem, _ := storage.NewMemoryStorage()
 			context, _ := state.NewAccountState(nil, mem)
 			owner := context.GetOrCreateUserAccount([]byte("account1"))
-			contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+			contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
 			ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
 
 			// direct run.
 			(func() {
 				engine := NewV8Engine(ctx)
-				err = engine.RunScriptSource(string(data), 0)
+				_, err = engine.RunScriptSource(string(data), 0)
 				assert.Equal(t, ErrExecutionTimeout, err)
 				engine.Dispose()
 			})()
@@ -267,7 +278,7 @@ func TestRunScriptSourceTimeout(t *testing.T) {
 
 				engine := NewV8Engine(ctx)
 				engine.AddModule(moduleID, string(data), 0)
-				err = engine.RunScriptSource(runnableSource, 0)
+				_, err = engine.RunScriptSource(runnableSource, 0)
 				assert.Equal(t, ErrExecutionTimeout, err)
 				engine.Dispose()
 			})()
@@ -295,24 +306,24 @@ func TestDeployAndInitAndCall(t *testing.T) {
 			context, _ := state.NewAccountState(nil, mem)
 			owner := context.GetOrCreateUserAccount([]byte("account1"))
 			owner.AddBalance(util.NewUint128FromInt(10000000))
-			contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+			contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
 
 			ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
 			engine := NewV8Engine(ctx)
 			engine.SetExecutionLimits(10000, 10000000)
-			err = engine.DeployAndInit(string(data), tt.sourceType, tt.initArgs)
+			_, err = engine.DeployAndInit(string(data), tt.sourceType, tt.initArgs)
 			assert.Nil(t, err)
 			engine.Dispose()
 
 			engine = NewV8Engine(ctx)
 			engine.SetExecutionLimits(10000, 10000000)
-			err = engine.Call(string(data), tt.sourceType, "dump", "")
+			_, err = engine.Call(string(data), tt.sourceType, "dump", "")
 			assert.Nil(t, err)
 			engine.Dispose()
 
 			engine = NewV8Engine(ctx)
 			engine.SetExecutionLimits(10000, 10000000)
-			err = engine.Call(string(data), tt.sourceType, "verify", tt.verifyArgs)
+			_, err = engine.Call(string(data), tt.sourceType, "verify", tt.verifyArgs)
 			assert.Nil(t, err)
 			engine.Dispose()
 
@@ -320,12 +331,12 @@ func TestDeployAndInitAndCall(t *testing.T) {
 			mem, _ = storage.NewMemoryStorage()
 			context, _ = state.NewAccountState(nil, mem)
 			owner = context.GetOrCreateUserAccount([]byte("account1"))
-			contract, _ = context.CreateContractAccount([]byte("account2"), nil)
+			contract, _ = context.CreateContractAccount([]byte("account2"), nil, nil, nil)
 
 			ctx = NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
 			engine = NewV8Engine(ctx)
 			engine.SetExecutionLimits(10000, 10000000)
-			err = engine.Call(string(data), tt.sourceType, "verify", tt.verifyArgs)
+			_, err = engine.Call(string(data), tt.sourceType, "verify", tt.verifyArgs)
 			assert.NotNil(t, err)
 			engine.Dispose()
 		})
@@ -336,6 +347,7 @@ func TestContracts(t *testing.T) {
 	type fields struct {
 		function string
 		args     string
+		result   string
 	}
 	tests := []struct {
 		contract   string
@@ -348,8 +360,8 @@ func TestContracts(t *testing.T) {
 			"js",
 			"[\"1024\", \"768\"]",
 			[]fields{
-				{"calcArea", "[]"},
-				{"verify", "[\"786432\"]"},
+				{"calcArea", "[]", "786432"},
+				{"verify", "[\"786432\"]", ""},
 			},
 		},
 		{
@@ -357,8 +369,8 @@ func TestContracts(t *testing.T) {
 			"js",
 			"[\"999\", \"123\"]",
 			[]fields{
-				{"calcArea", "[]"},
-				{"verify", "[\"122877\"]"},
+				{"calcArea", "[]", "122877"},
+				{"verify", "[\"122877\"]", ""},
 			},
 		},
 	}
@@ -372,13 +384,13 @@ func TestContracts(t *testing.T) {
 			context, _ := state.NewAccountState(nil, mem)
 			owner := context.GetOrCreateUserAccount([]byte("account1"))
 			owner.AddBalance(util.NewUint128FromInt(10000000))
-			contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+			contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
 			ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
 
 			// deploy and init.
 			engine := NewV8Engine(ctx)
 			engine.SetExecutionLimits(1000, 10000000)
-			err = engine.DeployAndInit(string(data), tt.sourceType, tt.initArgs)
+			_, err = engine.DeployAndInit(string(data), tt.sourceType, tt.initArgs)
 			assert.Nil(t, err)
 			engine.Dispose()
 
@@ -386,8 +398,9 @@ func TestContracts(t *testing.T) {
 			for _, fields := range tt.calls {
 				engine = NewV8Engine(ctx)
 				engine.SetExecutionLimits(1000, 10000000)
-				err = engine.Call(string(data), tt.sourceType, fields.function, fields.args)
+				result, err := engine.Call(string(data), tt.sourceType, fields.function, fields.args)
 				assert.Nil(t, err)
+				assert.Equal(t, fields.result, result)
 				engine.Dispose()
 			}
 		})
@@ -419,12 +432,12 @@ func TestFunctionNameCheck(t *testing.T) {
 			context, _ := state.NewAccountState(nil, mem)
 			owner := context.GetOrCreateUserAccount([]byte("account1"))
 			owner.AddBalance(util.NewUint128FromInt(1000000))
-			contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+			contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
 			ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
 
 			engine := NewV8Engine(ctx)
 			engine.SetExecutionLimits(1000, 10000000)
-			err = engine.Call(string(data), sourceType, tt.function, tt.args)
+			_, err = engine.Call(string(data), sourceType, tt.function, tt.args)
 			assert.Equal(t, tt.expectedErr, err)
 			engine.Dispose()
 		})
@@ -436,7 +449,7 @@ func TestMultiEngine(t *testing.T) {
 	context, _ := state.NewAccountState(nil, mem)
 	owner := context.GetOrCreateUserAccount([]byte("account1"))
 	owner.AddBalance(util.NewUint128FromInt(1000000))
-	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
 
 	var wg sync.WaitGroup
 	for i := 0; i < 100; i++ {
@@ -451,7 +464,7 @@ func TestMultiEngine(t *testing.T) {
 			engine.SetExecutionLimits(1000, 10000000)
 			defer engine.Dispose()
 
-			err := engine.RunScriptSource("console.log('running.');", 0)
+			_, err := engine.RunScriptSource("console.log('running.');", 0)
 			log.Infof("run script %d; err %v", idx, err)
 			assert.Nil(t, err)
 		}()
@@ -490,7 +503,7 @@ func TestInstructionCounterTestSuite(t *testing.T) {
 			context, _ := state.NewAccountState(nil, mem)
 			owner := context.GetOrCreateUserAccount([]byte("account1"))
 			owner.AddBalance(util.NewUint128FromInt(1000000000))
-			contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+			contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
 			ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
 
 			moduleID := tt.filepath
@@ -502,7 +515,7 @@ func TestInstructionCounterTestSuite(t *testing.T) {
 			if err != nil {
 				assert.Equal(t, tt.expectedErr, err)
 			} else {
-				err = engine.RunScriptSource(runnableSource, 0)
+				_, err = engine.RunScriptSource(runnableSource, 0)
 				assert.Equal(t, tt.expectedErr, err)
 			}
 			engine.Dispose()
@@ -527,7 +540,7 @@ func TestTypeScriptExecution(t *testing.T) {
 			context, _ := state.NewAccountState(nil, mem)
 			owner := context.GetOrCreateUserAccount([]byte("account1"))
 			owner.AddBalance(util.NewUint128FromInt(1000000000))
-			contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+			contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
 			ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
 
 			moduleID := tt.filepath
@@ -547,7 +560,7 @@ func TestTypeScriptExecution(t *testing.T) {
 			if err != nil {
 				assert.Equal(t, tt.expectedErr, err)
 			} else {
-				err = engine.RunScriptSource(runnableSource, 0)
+				_, err = engine.RunScriptSource(runnableSource, 0)
 				assert.Equal(t, tt.expectedErr, err)
 			}
 		})
@@ -560,7 +573,7 @@ func TestRunMozillaJSTestSuite(t *testing.T) {
 	owner := context.GetOrCreateUserAccount([]byte("account1"))
 	owner.AddBalance(util.NewUint128FromInt(1000000000))
 
-	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
 	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
 
 	var runTest func(dir string, shelljs string)
@@ -614,7 +627,7 @@ func TestRunMozillaJSTestSuite(t *testing.T) {
 			engine := NewV8Engine(ctx)
 			engine.SetTestingFlag(true)
 			engine.enableLimits = true
-			err = engine.RunScriptSource(buf.String(), 0)
+			_, err = engine.RunScriptSource(buf.String(), 0)
 			assert.Nil(t, err)
 		}
 	}
@@ -639,18 +652,243 @@ func TestBlockChain(t *testing.T) {
 			context, _ := state.NewAccountState(nil, mem)
 			owner := context.GetOrCreateUserAccount([]byte("8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf"))
 			owner.AddBalance(util.NewUint128FromInt(1000000000))
-			contract, _ := context.CreateContractAccount([]byte("16464b93292d7c99099d4d982a05140f12779f5e299d6eb4"), nil)
+			contract, _ := context.CreateContractAccount([]byte("16464b93292d7c99099d4d982a05140f12779f5e299d6eb4"), nil, nil, nil)
 
 			ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
 			engine := NewV8Engine(ctx)
 			engine.SetExecutionLimits(100000, 10000000)
-			err = engine.RunScriptSource(string(data), 0)
+			_, err = engine.RunScriptSource(string(data), 0)
 			assert.Equal(t, tt.expectedErr, err)
 			engine.Dispose()
 		})
 	}
 }
 
+func TestContractCall(t *testing.T) {
+	counterSource, err := ioutil.ReadFile("test/contract_counter.js")
+	assert.Nil(t, err)
+	callerSource, err := ioutil.ReadFile("test/contract_counter_caller.js")
+	assert.Nil(t, err)
+
+	ownerAddr, _ := byteutils.FromHex("8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf")
+	block := &mockBlock{sources: map[string]*ContractSource{
+		byteutils.Hex([]byte("counter")): {Owner: ownerAddr, Source: string(counterSource), SourceType: "js"},
+		byteutils.Hex([]byte("caller")):  {Owner: ownerAddr, Source: string(callerSource), SourceType: "js"},
+	}}
+	counterAddr := "22ac3a9a2b1c31b7a9084e46eae16e761f83f02324092b09"
+	callerAddr := "16464b93292d7c99099d4d982a05140f12779f5e299d6eb4"
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	context.BeginBatch()
+	owner := context.GetOrCreateUserAccount(ownerAddr)
+	counterBytes, _ := byteutils.FromHex(counterAddr)
+	counter, _ := context.CreateContractAccount(counterBytes, []byte("counter"), nil, nil)
+	callerBytes, _ := byteutils.FromHex(callerAddr)
+	caller, _ := context.CreateContractAccount(callerBytes, []byte("caller"), nil, nil)
+
+	engine := NewV8Engine(NewContext(block, testContextTransaction(), owner, counter, context))
+	engine.SetExecutionLimits(100000, 10000000)
+	_, err = engine.DeployAndInit(string(counterSource), "js", "")
+	assert.Nil(t, err)
+	engine.Dispose()
+
+	engine = NewV8Engine(NewContext(block, testContextTransaction(), owner, counter, context))
+	engine.SetExecutionLimits(100000, 10000000)
+	_, err = engine.Call(string(counterSource), "js", "incr", "[1]")
+	assert.Nil(t, err)
+	direct := engine.ExecutionInstructions()
+	engine.Dispose()
+
+	call := func(function, args string) (string, uint64, error) {
+		engine := NewV8Engine(NewContext(block, testContextTransaction(), owner, caller, context))
+		defer engine.Dispose()
+		engine.SetExecutionLimits(100000, 10000000)
+		result, err := engine.Call(string(callerSource), "js", function, args)
+		return result, engine.ExecutionInstructions(), err
+	}
+
+	// the instructions of the callee are charged to the caller.
+	block.events = nil
+	result, instructions, err := call("incr", fmt.Sprintf("[\"%s\", 2]", counterAddr))
+	assert.Nil(t, err)
+	assert.Equal(t, "3", result)
+	assert.True(t, instructions > direct)
+	assert.Equal(t, []string{EventNameSpaceContract + ".incr"}, block.events)
+
+	// the changes of the failed callee are rolled back, with its events and created accounts.
+	block.events = nil
+	result, _, err = call("incrAndFail", fmt.Sprintf("[\"%s\", 5]", counterAddr))
+	assert.Nil(t, err)
+	assert.Equal(t, "3", result)
+	assert.Nil(t, block.events)
+	_, err = context.GetContractAccount([]byte("1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c"))
+	assert.Equal(t, state.ErrAccountNotFound, err)
+
+	// negative, non-numeric and overflowed values are rejected before touching balances.
+	counter.AddBalance(util.NewUint128FromInt(1000))
+	for _, value := range []string{"-1000", "1e3", "abc", "340282366920938463463374607431768211456"} {
+		result, _, err = call("callWithValue", fmt.Sprintf("[\"%s\", \"%s\"]", counterAddr, value))
+		assert.Nil(t, err)
+		assert.Equal(t, "\"rejected\"", result, value)
+		assert.Equal(t, "1000", counter.Balance().String())
+		assert.Equal(t, "0", caller.Balance().String())
+	}
+
+	_, _, err = call("recurse", fmt.Sprintf("[\"%s\"]", callerAddr))
+	assert.Equal(t, ErrExecutionFailed, err)
+}
+
+func TestParseTransferValue(t *testing.T) {
+	tests := []struct {
+		value       string
+		expected    string
+		expectedErr error
+	}{
+		{"0", "0", nil},
+		{"1000", "1000", nil},
+		{"340282366920938463463374607431768211455", "340282366920938463463374607431768211455", nil},
+		{"340282366920938463463374607431768211456", "", ErrInvalidTransferValue},
+		{"-1", "", ErrInvalidTransferValue},
+		{"1.5", "", ErrInvalidTransferValue},
+		{"abc", "", ErrInvalidTransferValue},
+		{"", "", ErrInvalidTransferValue},
+	}
+	for _, tt := range tests {
+		value, err := parseTransferValue(tt.value)
+		assert.Equal(t, tt.expectedErr, err, tt.value)
+		if err == nil {
+			assert.Equal(t, tt.expected, value.String())
+		}
+	}
+}
+
+func TestPrepareContractScript(t *testing.T) {
+	source, err := ioutil.ReadFile("test/contract_counter.js")
+	assert.Nil(t, err)
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
+	newEngine := func() *V8Engine {
+		engine := NewV8Engine(NewContext(testContextBlock(), testContextTransaction(), owner, contract, context))
+		engine.SetExecutionLimits(100000, 10000000)
+		return engine
+	}
+
+	engine := newEngine()
+	_, _, err = engine.PrepareContractScript(string(source), "py")
+	assert.Equal(t, ErrUnsupportedSourceType, err)
+	traceable, lineOffset, err := engine.PrepareContractScript(string(source), "js")
+	assert.Nil(t, err)
+	_, err = engine.RunPreparedContractScript(traceable, lineOffset, "init", "")
+	assert.Nil(t, err)
+	engine.Dispose()
+
+	engine = newEngine()
+	result, err := engine.Call(string(source), "js", "incr", "[1]")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", result)
+	direct := engine.ExecutionInstructions()
+	engine.Dispose()
+
+	// the prepared script runs as the source does, at the same cost.
+	engine = newEngine()
+	prepared := &ContractSource{Source: string(source), SourceType: "js", Traceable: traceable, TraceableLineOffset: lineOffset}
+	result, err = engine.CallContract(prepared, "incr", "[1]")
+	assert.Nil(t, err)
+	assert.Equal(t, "2", result)
+	assert.Equal(t, direct, engine.ExecutionInstructions())
+	engine.Dispose()
+
+	engine = newEngine()
+	_, err = engine.CallPrepared(traceable, lineOffset, "init", "")
+	assert.Equal(t, ErrDisallowCallPrivateFunction, err)
+	engine.Dispose()
+}
+
+func TestScriptCache(t *testing.T) {
+	source, err := ioutil.ReadFile("test/contract_counter.js")
+	assert.Nil(t, err)
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
+	call := func(source *ContractSource, function string) (string, error) {
+		engine := NewV8Engine(NewContext(testContextBlock(), testContextTransaction(), owner, contract, context))
+		defer engine.Dispose()
+		engine.SetExecutionLimits(100000, 10000000)
+		return engine.CallContract(source, function, "")
+	}
+
+	engine := NewV8Engine(NewContext(testContextBlock(), testContextTransaction(), owner, contract, context))
+	engine.SetExecutionLimits(100000, 10000000)
+	_, err = engine.DeployAndInit(string(source), "js", "")
+	assert.Nil(t, err)
+	engine.Dispose()
+
+	codeHash := byteutils.Hash("counter code hash")
+	hits, misses := scriptCacheHitCounter.Count(), scriptCacheMissCounter.Count()
+	_, _, ok := GetPreparedScript(codeHash)
+	assert.False(t, ok)
+	result, err := call(&ContractSource{CodeHash: codeHash, Source: string(source), SourceType: "js"}, "get")
+	assert.Nil(t, err)
+	assert.Equal(t, "0", result)
+	assert.Equal(t, hits, scriptCacheHitCounter.Count())
+	assert.Equal(t, misses+1, scriptCacheMissCounter.Count())
+
+	// the script is prepared only once for a code hash, the source is not needed then.
+	traceable, lineOffset, ok := GetPreparedScript(codeHash)
+	assert.True(t, ok)
+	result, err = call(&ContractSource{CodeHash: codeHash, Traceable: traceable, TraceableLineOffset: lineOffset}, "get")
+	assert.Nil(t, err)
+	assert.Equal(t, "0", result)
+	assert.Equal(t, hits+1, scriptCacheHitCounter.Count())
+	assert.Equal(t, misses+1, scriptCacheMissCounter.Count())
+}
+
+func TestNativeFunctionGas(t *testing.T) {
+	run := func(source string, limitsOfExecutionInstructions uint64) (uint64, error) {
+		mem, _ := storage.NewMemoryStorage()
+		context, _ := state.NewAccountState(nil, mem)
+		owner := context.GetOrCreateUserAccount([]byte("account1"))
+		contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
+		engine := NewV8Engine(NewContext(testContextBlock(), testContextTransaction(), owner, contract, context))
+		defer engine.Dispose()
+		engine.SetExecutionLimits(limitsOfExecutionInstructions, 10000000)
+		_, err := engine.RunScriptSource(source, 0)
+		return engine.ExecutionInstructions(), err
+	}
+	put := func(size int) string {
+		return fmt.Sprintf("LocalContractStorage.put('k', '%s');", strings.Repeat("x", size))
+	}
+	putAndGet := func(size int) string {
+		return put(size) + "LocalContractStorage.get('k');"
+	}
+
+	// the scripts differ only in the size of value, each byte written is charged once.
+	small, err := run(put(100), 100000)
+	assert.Nil(t, err)
+	large, err := run(put(10000), 100000)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10000-100), large-small)
+
+	// each byte read is charged too.
+	smallGet, err := run(putAndGet(100), 100000)
+	assert.Nil(t, err)
+	largeGet, err := run(putAndGet(10000), 100000)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10000-100)*(1+StorageByteGas), largeGet-smallGet)
+
+	// the execution is stopped by the limits exactly.
+	_, err = run(put(10000), large)
+	assert.Nil(t, err)
+	_, err = run(put(10000), large-1)
+	assert.Equal(t, ErrInsufficientGas, err)
+}
+
 func TestBankVaultContract(t *testing.T) {
 	type TakeoutTest struct {
 		args        string
@@ -693,7 +931,7 @@ func TestBankVaultContract(t *testing.T) {
 			owner.AddBalance(util.NewUint128FromInt(10000000))
 
 			// prepare the contract.
-			contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+			contract, _ := context.CreateContractAccount([]byte("account2"), nil, nil, nil)
 			contract.AddBalance(util.NewUint128FromInt(5))
 
 			// parepare env, block & transactions.
@@ -702,20 +940,20 @@ func TestBankVaultContract(t *testing.T) {
 			// execute.
 			engine := NewV8Engine(ctx)
 			engine.SetExecutionLimits(10000, 100000000)
-			err = engine.DeployAndInit(string(data), tt.sourceType, "")
+			_, err = engine.DeployAndInit(string(data), tt.sourceType, "")
 			assert.Nil(t, err)
 			engine.Dispose()
 
 			engine = NewV8Engine(ctx)
 			engine.SetExecutionLimits(10000, 100000000)
-			err = engine.Call(string(data), tt.sourceType, "save", tt.saveArgs)
+			_, err = engine.Call(string(data), tt.sourceType, "save", tt.saveArgs)
 			assert.Nil(t, err)
 			engine.Dispose()
 
 			for _, tot := range tt.takeoutTests {
 				engine = NewV8Engine(ctx)
 				engine.SetExecutionLimits(10000, 100000000)
-				err = engine.Call(string(data), tt.sourceType, "takeout", tot.args)
+				_, err = engine.Call(string(data), tt.sourceType, "takeout", tot.args)
 				assert.Equal(t, err, tot.expectedErr)
 				engine.Dispose()
 			}
@@ -739,12 +977,12 @@ func TestEvent(t *testing.T) {
 			context, _ := state.NewAccountState(nil, mem)
 			owner := context.GetOrCreateUserAccount([]byte("8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf"))
 			owner.AddBalance(util.NewUint128FromInt(1000000000))
-			contract, _ := context.CreateContractAccount([]byte("16464b93292d7c99099d4d982a05140f12779f5e299d6eb4"), nil)
+			contract, _ := context.CreateContractAccount([]byte("16464b93292d7c99099d4d982a05140f12779f5e299d6eb4"), nil, nil, nil)
 
 			ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
 			engine := NewV8Engine(ctx)
 			engine.SetExecutionLimits(100000, 10000000)
-			err = engine.RunScriptSource(string(data), 0)
+			_, err = engine.RunScriptSource(string(data), 0)
 			engine.Dispose()
 		})
 	}
@@ -48,6 +48,10 @@ func EventTriggerFunc(handler unsafe.Pointer, topic, data *C.char) {
 		return
 	}
 
+	if e.chargeGas(EventTriggerGas) != nil {
+		return
+	}
+
 	log.WithFields(log.Fields{
 		"category": 0, // ChainEventCategory.
 		"topic":    gTopic,
@@ -56,5 +60,9 @@ func EventTriggerFunc(handler unsafe.Pointer, topic, data *C.char) {
 
 	txHash, _ := byteutils.FromHex(e.ctx.tx.Hash)
 	contractTopic := EventNameSpaceContract + "." + gTopic
+	if e.ctx.journal != nil {
+		e.ctx.journal.recordEvent(txHash, contractTopic, gData)
+		return
+	}
 	e.ctx.block.RecordEvent(txHash, contractTopic, gData)
 }
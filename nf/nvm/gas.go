@@ -0,0 +1,43 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+// Gas schedule of the native functions, in instructions charged on top of
+// the instructions executed by the script. The bytes written by storage put and
+// event trigger are charged by the instruction counter of the script.
+const (
+	StorageGetGas      uint64 = 50
+	StoragePutGas      uint64 = 100
+	StorageDelGas      uint64 = 50
+	GetTxByHashGas     uint64 = 100
+	GetAccountStateGas uint64 = 100
+	TransferGas        uint64 = 200
+	VerifyAddressGas   uint64 = 10
+	CallGas            uint64 = 500
+	EventTriggerGas    uint64 = 100
+	RequireGas         uint64 = 100
+
+	// StorageByteGas per byte of value read from storage.
+	StorageByteGas uint64 = 1
+)
+
+// storageGetGas return the gas to get value from storage.
+func storageGetGas(value []byte) uint64 {
+	return StorageGetGas + StorageByteGas*uint64(len(value))
+}
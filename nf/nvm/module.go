@@ -85,6 +85,9 @@ func RequireDelegateFunc(handler unsafe.Pointer, filename *C.char, lineOffset *C
 		}).Error("require delegate handler does not found.")
 		return nil
 	}
+	if e.chargeGas(RequireGas) != nil {
+		return nil
+	}
 
 	module := e.modules.Get(id)
 	if module == nil {
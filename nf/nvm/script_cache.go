@@ -0,0 +1,76 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+const (
+	// DefaultScriptCacheSize the number of prepared contract scripts kept in cache
+	DefaultScriptCacheSize = 1024
+)
+
+var (
+	scriptCache, _ = lru.New(DefaultScriptCacheSize)
+
+	scriptCacheHitCounter  = metrics.GetOrRegisterCounter("nvm_script_cache_hit", nil)
+	scriptCacheMissCounter = metrics.GetOrRegisterCounter("nvm_script_cache_miss", nil)
+)
+
+// preparedScript a contract script with tracing instructions injected
+type preparedScript struct {
+	source     string
+	lineOffset int
+}
+
+// GetPreparedScript return the prepared script of the code hash in cache, so that
+// the source of the contract needs not be loaded.
+func GetPreparedScript(codeHash byteutils.Hash) (string, int, bool) {
+	if len(codeHash) == 0 {
+		return "", 0, false
+	}
+	script, ok := scriptCache.Get(codeHash.Hex())
+	if !ok {
+		scriptCacheMissCounter.Inc(1)
+		return "", 0, false
+	}
+	scriptCacheHitCounter.Inc(1)
+	return script.(*preparedScript).source, script.(*preparedScript).lineOffset, true
+}
+
+// preparedContractScript return the prepared script of the contract, the source is prepared
+// and cached by its code hash if the script is not got from GetPreparedScript.
+// A code hash always maps to the same source, so the cache is shared by all engines.
+func (e *V8Engine) preparedContractScript(source *ContractSource) (*preparedScript, error) {
+	if len(source.Traceable) > 0 {
+		return &preparedScript{source: source.Traceable, lineOffset: source.TraceableLineOffset}, nil
+	}
+	traceable, lineOffset, err := e.PrepareContractScript(source.Source, source.SourceType)
+	if err != nil {
+		return nil, err
+	}
+	script := &preparedScript{source: traceable, lineOffset: lineOffset}
+	if len(source.CodeHash) > 0 {
+		scriptCache.Add(source.CodeHash.Hex(), script)
+	}
+	return script, nil
+}
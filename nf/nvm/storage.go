@@ -38,13 +38,13 @@ var (
 	keyPattern = regexp.MustCompile("^@([a-zA-Z_].*?)\\[(.+?)\\]$")
 )
 
-// hashStorageKey return the key hash.
+// HashStorageKey return the key hash.
 // There are two kinds of key, the one is ItemKey, the other is Map-ItemKey.
 // ItemKey in SmartContract is used for object storage.
 // For example, the ItemKey for the statement "token.totalSupply = 1000" is "totalSupply".
 // Map-ItemKey in SmartContrat is used for Map storage.
 // For example, the Map-ItemKey for the statement "token.balances.set('addr1', 100)" is "@balances[addr1]".
-func hashStorageKey(key string) []byte {
+func HashStorageKey(key string) []byte {
 	var domainKey, itemKey string
 
 	matches := keyPattern.FindAllStringSubmatch(key, -1)
@@ -62,12 +62,15 @@ func hashStorageKey(key string) []byte {
 // StorageGetFunc export StorageGetFunc
 //export StorageGetFunc
 func StorageGetFunc(handler unsafe.Pointer, key *C.char) *C.char {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
 	if storage == nil {
 		return nil
 	}
 
-	val, err := storage.Get([]byte(hashStorageKey(C.GoString(key))))
+	val, err := storage.Get([]byte(HashStorageKey(C.GoString(key))))
+	if engine.chargeGas(storageGetGas(val)) != nil {
+		return nil
+	}
 	if err != nil {
 		if err != ErrKeyNotFound {
 			log.WithFields(log.Fields{
@@ -86,14 +89,21 @@ func StorageGetFunc(handler unsafe.Pointer, key *C.char) *C.char {
 // StoragePutFunc export StoragePutFunc
 //export StoragePutFunc
 func StoragePutFunc(handler unsafe.Pointer, key *C.char, value *C.char) int {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
 	if storage == nil {
 		return 1
 	}
 
 	// log.Errorf("[--------------] StoragePutFunc, storage = %v; {%v: %v}", storage, C.GoString(key), C.GoString(value))
 
-	err := storage.Put([]byte(hashStorageKey(C.GoString(key))), []byte(C.GoString(value)))
+	gKey, gValue := C.GoString(key), C.GoString(value)
+	if engine.chargeGas(StoragePutGas) != nil {
+		return 1
+	}
+
+	hashedKey := HashStorageKey(gKey)
+	engine.ctx.journal.recordStorage(storage, hashedKey)
+	err := storage.Put(hashedKey, []byte(gValue))
 	if err != nil && err != ErrKeyNotFound {
 		log.WithFields(log.Fields{
 			"func":    "nvm.StoragePutFunc",
@@ -109,12 +119,17 @@ func StoragePutFunc(handler unsafe.Pointer, key *C.char, value *C.char) int {
 // StorageDelFunc export StorageDelFunc
 //export StorageDelFunc
 func StorageDelFunc(handler unsafe.Pointer, key *C.char) int {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
 	if storage == nil {
 		return 1
 	}
+	if engine.chargeGas(StorageDelGas) != nil {
+		return 1
+	}
 
-	err := storage.Del([]byte(hashStorageKey(C.GoString(key))))
+	hashedKey := HashStorageKey(C.GoString(key))
+	engine.ctx.journal.recordStorage(storage, hashedKey)
+	err := storage.Del(hashedKey)
 
 	if err != nil && err != ErrKeyNotFound {
 		log.WithFields(log.Fields{
@@ -0,0 +1,47 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+class Counter {
+    constructor() {
+        LocalContractStorage.defineProperties(this, {
+            count: null,
+        });
+    }
+
+    init() {
+        this.count = 0;
+    }
+
+    incr(n) {
+        this.count = this.count + n;
+        Event.Trigger("incr", {n: n});
+        return this.count;
+    }
+
+    incrAndFail(n) {
+        this.count = this.count + n;
+        Event.Trigger("incr", {n: n});
+        Blockchain.transfer("1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c", 0);
+        throw new Error("failed after incr");
+    }
+
+    get() {
+        return this.count;
+    }
+}
+
+module.exports = Counter;
@@ -32,6 +32,7 @@
 #include <v8.h>
 
 #include <assert.h>
+#include <string.h>
 
 using namespace v8;
 
@@ -133,6 +134,16 @@ int ExecuteSourceDataDelegate(Isolate *isolate, const char *source,
     return 1;
   }
 
+  // copy out the result, which is json-serialized by the contract runner.
+  char **result = static_cast<char **>(delegateContext);
+  if (result != NULL) {
+    Local<Value> value = ret.ToLocalChecked();
+    if (value->IsString()) {
+      String::Utf8Value str(value);
+      *result = strdup(*str);
+    }
+  }
+
   return 0;
 }
 
@@ -162,9 +173,9 @@ char *TranspileTypeScriptModule(V8Engine *e, const char *source,
 }
 
 int RunScriptSource(V8Engine *e, const char *source, int source_line_offset,
-                    uintptr_t lcsHandler, uintptr_t gcsHandler) {
+                    uintptr_t lcsHandler, uintptr_t gcsHandler, char **result) {
   return Execute(e, source, source_line_offset, (void *)lcsHandler,
-                 (void *)gcsHandler, ExecuteSourceDataDelegate, NULL);
+                 (void *)gcsHandler, ExecuteSourceDataDelegate, (void *)result);
 }
 
 int Execute(V8Engine *e, const char *source, int source_line_offset,
@@ -63,11 +63,15 @@ typedef char *(*GetTxByHashFunc)(void *handler, const char *hash);
 typedef char *(*GetAccountStateFunc)(void *handler, const char *address);
 typedef int (*TransferFunc)(void *handler, const char *to, const char *value);
 typedef int (*VerifyAddressFunc)(void *handler, const char *address);
+typedef char *(*CallFunc)(void *handler, const char *address,
+                          const char *function, const char *args,
+                          const char *value, size_t *gasCnt);
 
 EXPORT void InitializeBlockchain(GetTxByHashFunc getTx,
                                  GetAccountStateFunc getAccount,
                                  TransferFunc transfer,
-                                 VerifyAddressFunc verifyAddress);
+                                 VerifyAddressFunc verifyAddress,
+                                 CallFunc call);
 
 // version
 EXPORT char *GetV8Version();
@@ -109,7 +113,7 @@ EXPORT V8Engine *CreateEngine();
 
 EXPORT int RunScriptSource(V8Engine *e, const char *source,
                            int source_line_offset, uintptr_t lcsHandler,
-                           uintptr_t gcsHandler);
+                           uintptr_t gcsHandler, char **result);
 
 EXPORT char *InjectTracingInstructions(V8Engine *e, const char *source,
                                        int *source_line_offset);
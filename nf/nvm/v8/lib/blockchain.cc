@@ -19,19 +19,23 @@
 
 #include "blockchain.h"
 #include "../engine.h"
+#include "global.h"
 
 static GetTxByHashFunc sGetTxByHash = NULL;
 static GetAccountStateFunc sGetAccountState = NULL;
 static TransferFunc sTransfer = NULL;
 static VerifyAddressFunc sVerifyAddress = NULL;
+static CallFunc sCall = NULL;
 
 void InitializeBlockchain(GetTxByHashFunc getTx,
                           GetAccountStateFunc getAccount,
-                          TransferFunc transfer, VerifyAddressFunc verifyAddress) {
+                          TransferFunc transfer, VerifyAddressFunc verifyAddress,
+                          CallFunc call) {
   sGetTxByHash = getTx;
   sGetAccountState = getAccount;
   sTransfer = transfer;
   sVerifyAddress = verifyAddress;
+  sCall = call;
 }
 
 void NewBlockchainInstance(Isolate *isolate, Local<Context> context,
@@ -59,6 +63,11 @@ void NewBlockchainInstance(Isolate *isolate, Local<Context> context,
                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
                                                PropertyAttribute::ReadOnly));
 
+  blockTpl->Set(String::NewFromUtf8(isolate, "call"),
+                FunctionTemplate::New(isolate, CallCallback),
+                static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                               PropertyAttribute::ReadOnly));
+
   Local<Object> instance = blockTpl->NewInstance(context).ToLocalChecked();
   instance->SetInternalField(0, External::New(isolate, handler));
 
@@ -177,3 +186,47 @@ void VerifyAddressCallback(const FunctionCallbackInfo<Value> &info) {
   int ret = sVerifyAddress(handler->Value(), *String::Utf8Value(address->ToString()));
   info.GetReturnValue().Set(ret);
 }
+
+// CallCallback
+void CallCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 4) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "Blockchain.call() requires 4 arguments"));
+    return;
+  }
+
+  for (int i = 0; i < 4; i++) {
+    if (!info[i]->IsString()) {
+      isolate->ThrowException(String::NewFromUtf8(
+          isolate, "address, function, args and value must be string"));
+      return;
+    }
+  }
+
+  size_t cnt = 0;
+  char *value = sCall(handler->Value(), *String::Utf8Value(info[0]->ToString()),
+                      *String::Utf8Value(info[1]->ToString()),
+                      *String::Utf8Value(info[2]->ToString()),
+                      *String::Utf8Value(info[3]->ToString()), &cnt);
+
+  // charge the instructions executed by the callee to the caller.
+  V8Engine *e = GetV8EngineInstance(isolate->GetCurrentContext());
+  if (e != NULL) {
+    e->stats.count_of_executed_instructions += cnt;
+    if (IsEngineLimitsExceeded(e)) {
+      TerminateExecution(e);
+    }
+  }
+
+  if (value == NULL) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "Blockchain.call() failed"));
+    return;
+  }
+  info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+  free(value);
+}
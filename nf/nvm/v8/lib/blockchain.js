@@ -73,6 +73,17 @@ Blockchain.prototype = {
     },
     verifyAddress: function (address) {
         return this.nativeBlockchain.verifyAddress(address);
+    },
+    call: function (address, func, args, value) {
+        if (typeof args !== "string") {
+            args = JSON.stringify(args === undefined ? [] : args);
+        }
+        value = value === undefined ? "0" : value.toString();
+        var result = this.nativeBlockchain.call(address, func, args, value);
+        if (result.length === 0) {
+            return undefined;
+        }
+        return JSON.parse(result);
     }
 };
 
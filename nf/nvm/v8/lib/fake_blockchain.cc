@@ -49,3 +49,8 @@ char *GetAccountState(void *handler, const char *address) {
 int Transfer(void *handler, const char *to, const char *value) { return 1; }
 
 int VerifyAddress(void *handler, const char *address) { return 1; }
+
+char *Call(void *handler, const char *address, const char *function,
+           const char *args, const char *value, size_t *gasCnt) {
+  return NULL;
+}
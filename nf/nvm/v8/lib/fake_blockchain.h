@@ -20,9 +20,13 @@
 #ifndef _NEBULAS_NF_NVM_V8_LIB_FAKE_BLOCKCHAIN_H_
 #define _NEBULAS_NF_NVM_V8_LIB_FAKE_BLOCKCHAIN_H_
 
+#include <stddef.h>
+
 char *GetTxByHash(void *handler, const char *hash);
 char *GetAccountState(void *handler, const char *address);
 int Transfer(void *handler, const char *to, const char *value);
 int VerifyAddress(void *handler, const char *address);
+char *Call(void *handler, const char *address, const char *function,
+           const char *args, const char *value, size_t *gasCnt);
 
 #endif //_NEBULAS_NF_NVM_V8_LIB_FAKE_BLOCKCHAIN_H_
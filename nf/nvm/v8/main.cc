@@ -89,8 +89,9 @@ void RunScriptSourceDelegate(V8Engine *e, const char *data,
     if (traceableSource == NULL) {
       fprintf(stderr, "Inject tracing instructions failed.\n");
     } else {
-      int ret = RunScriptSource(e, traceableSource, lineOffset,
-                                (uintptr_t)lcsHandler, (uintptr_t)gcsHandler);
+      int ret =
+          RunScriptSource(e, traceableSource, lineOffset, (uintptr_t)lcsHandler,
+                          (uintptr_t)gcsHandler, NULL);
       free(traceableSource);
 
       fprintf(stdout, "[V8] Execution ret = %d\n", ret);
@@ -126,7 +127,7 @@ void RunScriptSourceDelegate(V8Engine *e, const char *data,
     }
   } else {
     RunScriptSource(e, data, lineOffset, (uintptr_t)lcsHandler,
-                    (uintptr_t)gcsHandler);
+                    (uintptr_t)gcsHandler, NULL);
   }
 }
 
@@ -220,7 +221,8 @@ int main(int argc, const char *argv[]) {
   InitializeLogger(logFunc);
   InitializeRequireDelegate(RequireDelegateFunc);
   InitializeStorage(StorageGet, StoragePut, StorageDel);
-  InitializeBlockchain(GetTxByHash, GetAccountState, Transfer, VerifyAddress);
+  InitializeBlockchain(GetTxByHash, GetAccountState, Transfer, VerifyAddress,
+                       Call);
   InitializeEvent(eventTriggerFunc);
 
   int argcIdx = 1;
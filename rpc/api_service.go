@@ -22,7 +22,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/nebulasio/go-nebulas/common/trie"
 
@@ -32,6 +35,7 @@ import (
 	"github.com/nebulasio/go-nebulas/crypto/hash"
 	nnet "github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
@@ -52,6 +56,8 @@ func (s *APIService) GetNebState(ctx context.Context, req *rpcpb.NonParamsReques
 	resp := &rpcpb.GetNebStateResponse{}
 	resp.ChainId = neb.BlockChain().ChainID()
 	resp.Tail = tail.Hash().String()
+	resp.Lib = neb.BlockChain().LIB().Hash().String()
+	resp.LibHeight = neb.BlockChain().LIB().Height()
 	resp.Coinbase = tail.Coinbase().String()
 	resp.Synchronized = neb.NetService().Node().GetSynchronized()
 	resp.PeerCount = getStreamCount(neb.NetService().Node().GetStream())
@@ -134,12 +140,49 @@ func (s *APIService) GetAccountState(ctx context.Context, req *rpcpb.GetAccountS
 	if err != nil {
 		return nil, err
 	}
+	var block *core.Block
+	if len(req.BlockHash) > 0 || req.Height > 0 {
+		block, err = stateBlock(neb.BlockChain(), req.BlockHash, req.Height)
+	} else {
+		block, err = selectBlock(neb.BlockChain(), req.Block)
+	}
+	if err != nil {
+		return nil, err
+	}
+	accState, err := neb.BlockChain().StateAt(block)
+	if err != nil {
+		return nil, err
+	}
+	account := accState.GetOrCreateUserAccount(addr.Bytes())
+
+	return &rpcpb.GetAccountStateResponse{Balance: account.Balance().String(), Nonce: fmt.Sprintf("%d", account.Nonce())}, nil
+}
 
-	// TODO: handle specific block number.
-	balance := neb.BlockChain().TailBlock().GetBalance(addr.Bytes())
-	nonce := neb.BlockChain().TailBlock().GetNonce(addr.Bytes())
+// GetContractStorage return the value of a key in the contract storage.
+func (s *APIService) GetContractStorage(ctx context.Context, req *rpcpb.GetContractStorageRequest) (*rpcpb.GetContractStorageResponse, error) {
+	neb := s.server.Neblet()
 
-	return &rpcpb.GetAccountStateResponse{Balance: balance.String(), Nonce: fmt.Sprintf("%d", nonce)}, nil
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	block, err := stateBlock(neb.BlockChain(), req.BlockHash, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	accState, err := neb.BlockChain().StateAt(block)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := accState.GetContractAccount(addr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	value, err := contract.Get(nvm.HashStorageKey(req.Key))
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetContractStorageResponse{Value: string(value)}, nil
 }
 
 // GetDynasty is the RPC API handler.
@@ -160,21 +203,52 @@ func (s *APIService) SendTransaction(ctx context.Context, req *rpcpb.Transaction
 	return s.sendTransaction(req)
 }
 
-// Call is the RPC API handler.
-func (s *APIService) Call(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.SendTransactionResponse, error) {
-	return s.sendTransaction(req)
+// Call is the RPC API handler, it simulates the contract call without broadcasting.
+func (s *APIService) Call(ctx context.Context, req *rpcpb.CallRequest) (*rpcpb.CallResponse, error) {
+	neb := s.server.Neblet()
+	block, err := stateBlock(neb.BlockChain(), req.BlockHash, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// the call is free, so allow the max gas if gasLimit is not specified.
+	gasLimit := req.GasLimit
+	if len(gasLimit) == 0 {
+		gasLimit = core.TransactionMaxGas.String()
+	}
+	tx, err := parseTransaction(neb, &rpcpb.TransactionRequest{
+		From:     req.From,
+		To:       req.To,
+		Value:    req.Value,
+		Nonce:    req.Nonce,
+		GasPrice: req.GasPrice,
+		GasLimit: gasLimit,
+		Contract: req.Contract,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := neb.BlockChain().SimulateCall(tx, block)
+	if err != nil {
+		return nil, err
+	}
+	events := []*rpcpb.Event{}
+	for _, v := range result.Events {
+		events = append(events, &rpcpb.Event{Topic: v.Topic, Data: v.Data})
+	}
+	resp := &rpcpb.CallResponse{Result: result.Result, GasUsed: result.GasUsed.String(), Events: events}
+	if result.Err != nil {
+		resp.ExecuteError = result.Err.Error()
+	}
+	return resp, nil
 }
 
 func (s *APIService) sendTransaction(req *rpcpb.TransactionRequest) (*rpcpb.SendTransactionResponse, error) {
 	neb := s.server.Neblet()
-	tail := neb.BlockChain().TailBlock()
-	addr, err := core.AddressParse(req.From)
-	if err != nil {
+	if err := fillNonce(neb, req); err != nil {
 		return nil, err
 	}
-	if req.Nonce <= tail.GetNonce(addr.Bytes()) {
-		return nil, errors.New("nonce is invalid")
-	}
 
 	tx, err := parseTransaction(neb, req)
 	if err != nil {
@@ -194,6 +268,22 @@ func (s *APIService) sendTransaction(req *rpcpb.TransactionRequest) (*rpcpb.Send
 	return &rpcpb.SendTransactionResponse{Txhash: tx.Hash().String()}, nil
 }
 
+// fillNonce set the nonce following the pooled txs of the sender if it is not specified.
+func fillNonce(neb Neblet, req *rpcpb.TransactionRequest) error {
+	addr, err := core.AddressParse(req.From)
+	if err != nil {
+		return err
+	}
+	if req.Nonce == 0 {
+		req.Nonce = neb.BlockChain().TransactionPool().GetNextNonce(addr.Bytes())
+		return nil
+	}
+	if req.Nonce <= neb.BlockChain().TailBlock().GetNonce(addr.Bytes()) {
+		return errors.New("nonce is invalid")
+	}
+	return nil
+}
+
 func parseTransaction(neb Neblet, reqTx *rpcpb.TransactionRequest) (*core.Transaction, error) {
 	fromAddr, err := core.AddressParse(reqTx.From)
 	if err != nil {
@@ -272,6 +362,21 @@ func (s *APIService) GetBlockByHash(ctx context.Context, req *rpcpb.GetBlockByHa
 	return pbBlock.(*corepb.Block), nil
 }
 
+// GetBlockByHeight get block info by the block height in canonical chain
+func (s *APIService) GetBlockByHeight(ctx context.Context, req *rpcpb.GetBlockByHeightRequest) (*corepb.Block, error) {
+	neb := s.server.Neblet()
+
+	block := neb.BlockChain().GetBlockByHeight(req.GetHeight())
+	if block == nil {
+		return nil, errors.New("block not found")
+	}
+	pbBlock, err := block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return pbBlock.(*corepb.Block), nil
+}
+
 // BlockDump is the RPC API handler.
 func (s *APIService) BlockDump(ctx context.Context, req *rpcpb.BlockDumpRequest) (*rpcpb.BlockDumpResponse, error) {
 	neb := s.server.Neblet()
@@ -287,31 +392,38 @@ func (s *APIService) GetTransactionReceipt(ctx context.Context, req *rpcpb.GetTr
 	if tx == nil {
 		return nil, errors.New("transaction not found")
 	}
+	block := neb.BlockChain().GetTransactionBlock(bhash)
+	if block == nil {
+		return nil, errors.New("transaction not found")
+	}
+	result, err := block.FetchExecutionResultEvent(tx.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.TransactionReceiptResponse{
+		Hash:          byteutils.Hex(tx.Hash()),
+		From:          tx.From().String(),
+		To:            tx.To().String(),
+		Nonce:         tx.Nonce(),
+		Timestamp:     tx.Timestamp(),
+		ChainId:       tx.ChainID(),
+		BlockHash:     byteutils.Hex(block.Hash()),
+		BlockHeight:   block.Height(),
+		Status:        int32(result.Status),
+		GasUsed:       result.GasUsed,
+		ExecuteError:  result.Error,
+		ExecuteResult: result.Result,
+	}
 	if tx.From().String() == tx.To().String() {
 		contractAddr, err := tx.GenerateContractAddress()
 		if err != nil {
 			return nil, err
 		}
-		return &rpcpb.TransactionReceiptResponse{
-			Hash:            byteutils.Hex(tx.Hash()),
-			From:            tx.From().String(),
-			To:              tx.To().String(),
-			Nonce:           tx.Nonce(),
-			Timestamp:       tx.Timestamp(),
-			ChainId:         tx.ChainID(),
-			ContractAddress: contractAddr.String(),
-			Data:            string(tx.Data()),
-		}, nil
-	}
-
-	return &rpcpb.TransactionReceiptResponse{
-		Hash:      byteutils.Hex(tx.Hash()),
-		From:      tx.From().String(),
-		To:        tx.To().String(),
-		Nonce:     tx.Nonce(),
-		Timestamp: tx.Timestamp(),
-		ChainId:   tx.ChainID(),
-	}, nil
+		resp.ContractAddress = contractAddr.String()
+		resp.Data = string(tx.Data())
+	}
+	return resp, nil
 }
 
 // NewAccount generate a new address with passphrase
@@ -355,6 +467,9 @@ func (s *APIService) LockAccount(ctx context.Context, req *rpcpb.LockAccountRequ
 // SignTransaction sign transaction with the from addr passphrase
 func (s *APIService) SignTransaction(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.SignTransactionResponse, error) {
 	neb := s.server.Neblet()
+	if err := fillNonce(neb, req); err != nil {
+		return nil, err
+	}
 	tx, err := parseTransaction(neb, req)
 	if err != nil {
 		return nil, err
@@ -376,6 +491,9 @@ func (s *APIService) SignTransaction(ctx context.Context, req *rpcpb.Transaction
 // SendTransactionWithPassphrase send transaction with the from addr passphrase
 func (s *APIService) SendTransactionWithPassphrase(ctx context.Context, req *rpcpb.SendTransactionPassphraseRequest) (*rpcpb.SendTransactionPassphraseResponse, error) {
 	neb := s.server.Neblet()
+	if err := fillNonce(neb, req.Transaction); err != nil {
+		return nil, err
+	}
 	tx, err := parseTransaction(neb, req.Transaction)
 	if err != nil {
 		return nil, err
@@ -455,46 +573,133 @@ func (s *APIService) Subscribe(req *rpcpb.SubscribeRequest, gs rpcpb.ApiService_
 	}
 }
 
-// GetGasPrice get gas price from chain.
+// GetGasPrice get the gas prices suggested by the oracle.
 func (s *APIService) GetGasPrice(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.GasPriceResponse, error) {
 	neb := s.server.Neblet()
-	gasPrice := neb.BlockChain().GasPrice()
-	return &rpcpb.GasPriceResponse{GasPrice: gasPrice.String()}, nil
+	prices := neb.BlockChain().GasPriceOracle().GasPrices()
+	return &rpcpb.GasPriceResponse{
+		GasPrice: prices.Suggested.String(),
+		Low:      prices.Low.String(),
+		Median:   prices.Median.String(),
+		High:     prices.High.String(),
+	}, nil
+}
+
+// GetTxPoolStatus return the status and limits of the transaction pool.
+func (s *APIService) GetTxPoolStatus(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.TxPoolStatusResponse, error) {
+	neb := s.server.Neblet()
+	status := neb.BlockChain().TransactionPool().Status()
+	return &rpcpb.TxPoolStatusResponse{
+		Count:        uint32(status.Count),
+		Accounts:     uint32(status.Accounts),
+		Size:         uint32(status.Size),
+		AccountSlots: uint32(status.AccountSlots),
+		Lifetime:     uint64(status.Lifetime / time.Second),
+		Pending:      uint32(status.Pending),
+		Queued:       uint32(status.Queued),
+	}, nil
+}
+
+// GetPendingTransactions return the pending and queued txs of an address in the transaction pool.
+func (s *APIService) GetPendingTransactions(ctx context.Context, req *rpcpb.PoolAccountRequest) (*rpcpb.PendingTransactionsResponse, error) {
+	neb := s.server.Neblet()
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	pending, queued := neb.BlockChain().TransactionPool().GetAccountTransactions(addr.Bytes())
+	resp := &rpcpb.PendingTransactionsResponse{}
+	if resp.Pending, err = toProtoTransactions(pending); err != nil {
+		return nil, err
+	}
+	if resp.Queued, err = toProtoTransactions(queued); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetPooledTransaction return a tx in the transaction pool by hash.
+func (s *APIService) GetPooledTransaction(ctx context.Context, req *rpcpb.GetTransactionByHashRequest) (*corepb.Transaction, error) {
+	neb := s.server.Neblet()
+	thash, err := byteutils.FromHex(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	tx := neb.BlockChain().TransactionPool().GetTransaction(thash)
+	if tx == nil {
+		return nil, errors.New("transaction not found")
+	}
+	pbTx, err := tx.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return pbTx.(*corepb.Transaction), nil
+}
+
+// GetNextNonce return the nonce of the next tx of an address, following its pending txs.
+func (s *APIService) GetNextNonce(ctx context.Context, req *rpcpb.PoolAccountRequest) (*rpcpb.NextNonceResponse, error) {
+	neb := s.server.Neblet()
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.NextNonceResponse{Nonce: neb.BlockChain().TransactionPool().GetNextNonce(addr.Bytes())}, nil
+}
+
+func toProtoTransactions(txs []*core.Transaction) ([]*corepb.Transaction, error) {
+	var pbTxs []*corepb.Transaction
+	for _, tx := range txs {
+		pbTx, err := tx.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		pbTxs = append(pbTxs, pbTx.(*corepb.Transaction))
+	}
+	return pbTxs, nil
 }
 
 // EstimateGas Compute the smart contract gas consumption.
 func (s *APIService) EstimateGas(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.EstimateGasResponse, error) {
 	neb := s.server.Neblet()
-	tail := neb.BlockChain().TailBlock()
 	addr, err := core.AddressParse(req.From)
 	if err != nil {
 		return nil, err
 	}
-	if req.Nonce <= tail.GetNonce(addr.Bytes()) {
-		return nil, errors.New("nonce is invalid")
+	// the nonce is not checked in a dry run, but a deployed contract address depends on it.
+	if req.Nonce == 0 {
+		req.Nonce = neb.BlockChain().TransactionPool().GetNextNonce(addr.Bytes())
 	}
 
 	tx, err := parseTransaction(neb, req)
 	if err != nil {
 		return nil, err
 	}
-	estimateGas, err := neb.BlockChain().EstimateGas(tx)
+	result, err := neb.BlockChain().EstimateGas(tx)
 	if err != nil {
 		return nil, err
 	}
-	return &rpcpb.EstimateGasResponse{EstimateGas: estimateGas.String()}, nil
+	resp := &rpcpb.EstimateGasResponse{EstimateGas: result.GasUsed.String()}
+	if result.Err != nil {
+		resp.Failed = true
+		resp.ExecuteError = result.Err.Error()
+	}
+	return resp, nil
 }
 
 // GetEventsByHash return events by tx hash.
 func (s *APIService) GetEventsByHash(ctx context.Context, req *rpcpb.GetTransactionByHashRequest) (*rpcpb.EventsResponse, error) {
 	neb := s.server.Neblet()
 	bhash, _ := byteutils.FromHex(req.GetHash())
-	tx, err := neb.BlockChain().TailBlock().GetTransaction(bhash)
+	block := neb.BlockChain().GetTransactionBlock(bhash)
+	if block == nil {
+		return nil, errors.New("transaction not found")
+	}
+	tx, err := block.GetTransaction(bhash)
 	if err != nil {
 		return nil, err
 	}
 	if tx != nil {
-		result, err := neb.BlockChain().TailBlock().FetchEvents(tx.Hash())
+		result, err := block.FetchEvents(tx.Hash())
 		if err != nil {
 			return nil, err
 		}
@@ -511,6 +716,131 @@ func (s *APIService) GetEventsByHash(ctx context.Context, req *rpcpb.GetTransact
 
 }
 
+// GetAccountProof return the account and its merkle proof in the state trie of a block.
+func (s *APIService) GetAccountProof(ctx context.Context, req *rpcpb.GetAccountProofRequest) (*rpcpb.AccountProofResponse, error) {
+	neb := s.server.Neblet()
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	block, err := stateBlock(neb.BlockChain(), req.BlockHash, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	account, proof, err := block.ProveAccount(addr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.AccountProofResponse{
+		BlockHash: block.Hash().String(),
+		StateRoot: block.StateRoot().String(),
+		Account:   account,
+		Proof:     proof,
+	}, nil
+}
+
+// GetStorageProof return the contract account and the storage value of a key with their merkle proofs.
+func (s *APIService) GetStorageProof(ctx context.Context, req *rpcpb.GetStorageProofRequest) (*rpcpb.StorageProofResponse, error) {
+	neb := s.server.Neblet()
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	block, err := stateBlock(neb.BlockChain(), req.BlockHash, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	key := nvm.HashStorageKey(req.Key)
+	account, accountProof, value, storageProof, err := block.ProveStorage(addr.Bytes(), key)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.StorageProofResponse{
+		BlockHash:    block.Hash().String(),
+		StateRoot:    block.StateRoot().String(),
+		Account:      account,
+		AccountProof: accountProof,
+		StorageKey:   byteutils.Hex(key),
+		Value:        value,
+		StorageProof: storageProof,
+	}, nil
+}
+
+// GetTransactionProof return the transaction and its merkle proof in the txs trie of its block.
+func (s *APIService) GetTransactionProof(ctx context.Context, req *rpcpb.GetTransactionByHashRequest) (*rpcpb.TransactionProofResponse, error) {
+	neb := s.server.Neblet()
+
+	thash, err := byteutils.FromHex(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	block := neb.BlockChain().GetTransactionBlock(thash)
+	if block == nil {
+		return nil, errors.New("transaction not found")
+	}
+	tx, proof, err := block.ProveTransaction(thash)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.TransactionProofResponse{
+		BlockHash:   block.Hash().String(),
+		TxsRoot:     block.TxsRoot().String(),
+		Transaction: tx,
+		Proof:       proof,
+	}, nil
+}
+
+// stateBlock return the block whose state is queried, selected by hex hash first,
+// then by height in canonical chain, and the tail block if neither is given.
+func stateBlock(bc *core.BlockChain, blockHash string, height uint64) (*core.Block, error) {
+	var block *core.Block
+	switch {
+	case len(blockHash) > 0:
+		bhash, err := byteutils.FromHex(blockHash)
+		if err != nil {
+			return nil, err
+		}
+		block = bc.GetBlock(bhash)
+	case height > 0:
+		block = bc.GetBlockByHeight(height)
+	default:
+		block = bc.TailBlock()
+	}
+	return checkStateBlock(bc, block)
+}
+
+// selectBlock return the block whose state is queried, selected by hex height
+// or one of "latest", "earliest" and "pending", and the tail block if not given.
+func selectBlock(bc *core.BlockChain, selector string) (*core.Block, error) {
+	var block *core.Block
+	switch selector {
+	case "", "latest", "pending":
+		block = bc.TailBlock()
+	case "earliest":
+		block = bc.GenesisBlock()
+	default:
+		height, err := strconv.ParseUint(strings.TrimPrefix(selector, "0x"), 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		block = bc.GetBlockByHeight(height)
+	}
+	return checkStateBlock(bc, block)
+}
+
+// checkStateBlock make sure the block exists and its state is not pruned.
+func checkStateBlock(bc *core.BlockChain, block *core.Block) (*core.Block, error) {
+	if block == nil {
+		return nil, errors.New("block not found")
+	}
+	if pruner := bc.StatePruner(); pruner != nil && pruner.IsPruned(block.Height()) {
+		return nil, core.ErrStatePruned
+	}
+	return block, nil
+}
+
 // ChangeNetworkID change the network id
 func (s *APIService) ChangeNetworkID(ctx context.Context, req *rpcpb.ChangeNetworkIDRequest) (*rpcpb.ChangeNetworkIDResponse, error) {
 	neb := s.server.Neblet()
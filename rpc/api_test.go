@@ -19,16 +19,131 @@
 package rpc
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/net/p2p"
 	"github.com/nebulasio/go-nebulas/rpc/mock_pb"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
 )
 
+var mockDynasty = []string{
+	"1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
+	"2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8",
+	"333cb3ed8c417971845382ede3cf67a0a96270c05fe2f700",
+	"48f981ed38910f1232c1bab124f650c482a57271632db9e3",
+	"59fc526072b09af8a8ca9732dae17132c4e9127e43cf2232",
+	"75e4e5a71d647298b88928d8cb5da43d90ab1a6c52d0905f",
+}
+
+type mockNeb struct {
+	genesis *corepb.Genesis
+	storage storage.Storage
+	emitter *core.EventEmitter
+	chain   *core.BlockChain
+}
+
+func (n *mockNeb) Genesis() *corepb.Genesis {
+	return n.genesis
+}
+
+func (n *mockNeb) Storage() storage.Storage {
+	return n.storage
+}
+
+func (n *mockNeb) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+func (n *mockNeb) StartSync() {}
+
+func (n *mockNeb) Config() nebletpb.Config {
+	return nebletpb.Config{}
+}
+
+func (n *mockNeb) BlockChain() *core.BlockChain {
+	return n.chain
+}
+
+func (n *mockNeb) AccountManager() *account.Manager {
+	return nil
+}
+
+func (n *mockNeb) NetService() *p2p.NetService {
+	return nil
+}
+
+type mockServer struct {
+	neb *mockNeb
+}
+
+func (s *mockServer) Start() error {
+	return nil
+}
+
+func (s *mockServer) Stop() {}
+
+func (s *mockServer) Neblet() Neblet {
+	return s.neb
+}
+
+func (s *mockServer) RunGateway() error {
+	return nil
+}
+
+type mockConsensus struct{}
+
+func (c mockConsensus) FastVerifyBlock(block *core.Block) error {
+	block.SetMiner(block.Coinbase())
+	return nil
+}
+
+func (c mockConsensus) VerifyBlock(block *core.Block, parent *core.Block) error {
+	block.SetMiner(block.Coinbase())
+	return nil
+}
+
+// testAPIService return an api service over a chain of the given height, mined by the first dynasty member.
+func testAPIService(t *testing.T, height int) *APIService {
+	stor, _ := storage.NewMemoryStorage()
+	neb := &mockNeb{
+		genesis: &corepb.Genesis{
+			Meta:      &corepb.GenesisMeta{ChainId: 0},
+			Consensus: &corepb.GenesisConsensus{Dpos: &corepb.GenesisConsensusDpos{Dynasty: mockDynasty}},
+			TokenDistribution: []*corepb.GenesisTokenDistribution{
+				&corepb.GenesisTokenDistribution{Address: mockDynasty[0], Value: "10000000000000000000000"},
+			},
+		},
+		storage: stor,
+		emitter: core.NewEventEmitter(),
+	}
+	bc, err := core.NewBlockChain(neb)
+	assert.Nil(t, err)
+	bc.SetConsensusHandler(mockConsensus{})
+	coinbase, _ := core.AddressParse(mockDynasty[0])
+	for i := 1; i < height; i++ {
+		block, err := bc.NewBlock(coinbase)
+		assert.Nil(t, err)
+		block.SetTimestamp(core.BlockInterval * int64(i))
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, bc.BlockPool().Push(block))
+		assert.Nil(t, bc.SetTailBlock(block))
+	}
+	neb.chain = bc
+	return &APIService{server: &mockServer{neb: neb}}
+}
+
 func TestAPIService_GetNebState(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -59,7 +174,42 @@ func TestGetAccountState(t *testing.T) {
 		assert.Equal(t, expected, resp)
 	}
 
-	// TODO: test with mock neblet.
+	api := testAPIService(t, 4)
+	bc := api.server.Neblet().BlockChain()
+	coinbase, _ := core.AddressParse(mockDynasty[0])
+	balanceAt := func(block *core.Block) string {
+		accState, err := bc.StateAt(block)
+		assert.Nil(t, err)
+		return accState.GetOrCreateUserAccount(coinbase.Bytes()).Balance().String()
+	}
+
+	// the coinbase is rewarded by every block, so each block has its own balance.
+	block := bc.GetBlockByHeight(2)
+	assert.NotEqual(t, balanceAt(block), balanceAt(bc.TailBlock()))
+
+	cases := []struct {
+		req   *rpcpb.GetAccountStateRequest
+		block *core.Block
+	}{
+		{&rpcpb.GetAccountStateRequest{}, bc.TailBlock()},
+		{&rpcpb.GetAccountStateRequest{BlockHash: block.Hash().String()}, block},
+		{&rpcpb.GetAccountStateRequest{BlockHash: block.Hash().String(), Height: 3, Block: "latest"}, block},
+		{&rpcpb.GetAccountStateRequest{Height: 2}, block},
+		{&rpcpb.GetAccountStateRequest{Block: "0x2"}, block},
+		{&rpcpb.GetAccountStateRequest{Block: "earliest"}, bc.GenesisBlock()},
+		{&rpcpb.GetAccountStateRequest{Block: "pending"}, bc.TailBlock()},
+	}
+	for i, c := range cases {
+		c.req.Address = mockDynasty[0]
+		resp, err := api.GetAccountState(context.Background(), c.req)
+		assert.Nil(t, err, fmt.Sprintf("case %d", i))
+		assert.Equal(t, balanceAt(c.block), resp.Balance, fmt.Sprintf("case %d", i))
+	}
+
+	_, err := api.GetAccountState(context.Background(), &rpcpb.GetAccountStateRequest{Address: mockDynasty[0], BlockHash: "1234"})
+	assert.NotNil(t, err)
+	_, err = api.GetAccountState(context.Background(), &rpcpb.GetAccountStateRequest{Address: mockDynasty[0], Block: "0x10"})
+	assert.NotNil(t, err)
 }
 
 func TestSendTransaction(t *testing.T) {
@@ -27,7 +27,10 @@ It has these top-level messages:
 	DelegateRequest
 	SendRawTransactionRequest
 	SendTransactionResponse
+	CallRequest
+	CallResponse
 	GetBlockByHashRequest
+	GetBlockByHeightRequest
 	GetTransactionByHashRequest
 	BlockDumpRequest
 	BlockDumpResponse
@@ -45,6 +48,17 @@ It has these top-level messages:
 	EstimateGasResponse
 	EventsResponse
 	Event
+	GetAccountProofRequest
+	AccountProofResponse
+	GetStorageProofRequest
+	StorageProofResponse
+	TransactionProofResponse
+	GetContractStorageRequest
+	GetContractStorageResponse
+	TxPoolStatusResponse
+	PoolAccountRequest
+	PendingTransactionsResponse
+	NextNonceResponse
 */
 package rpcpb
 
@@ -342,6 +356,10 @@ type GetNebStateResponse struct {
 	ProtocolVersion string `protobuf:"bytes,6,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
 	// The peer sync status.
 	Synchronized bool `protobuf:"varint,7,opt,name=synchronized,proto3" json:"synchronized,omitempty"`
+	// Last irreversible block hash
+	Lib string `protobuf:"bytes,8,opt,name=lib,proto3" json:"lib,omitempty"`
+	// Last irreversible block height
+	LibHeight uint64 `protobuf:"varint,9,opt,name=lib_height,json=libHeight,proto3" json:"lib_height,omitempty"`
 }
 
 func (m *GetNebStateResponse) Reset()                    { *m = GetNebStateResponse{} }
@@ -398,6 +416,20 @@ func (m *GetNebStateResponse) GetSynchronized() bool {
 	return false
 }
 
+func (m *GetNebStateResponse) GetLib() string {
+	if m != nil {
+		return m.Lib
+	}
+	return ""
+}
+
+func (m *GetNebStateResponse) GetLibHeight() uint64 {
+	if m != nil {
+		return m.LibHeight
+	}
+	return 0
+}
+
 // Response message of Accounts rpc.
 type AccountsResponse struct {
 	// Account list
@@ -422,6 +454,10 @@ type GetAccountStateRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// Hex string block number, or one of "latest", "earliest" or "pending". If not specified, use "latest".
 	Block string `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	// Hex string of block hash, takes precedence over height and block.
+	BlockHash string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block height in canonical chain, takes precedence over block.
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (m *GetAccountStateRequest) Reset()                    { *m = GetAccountStateRequest{} }
@@ -443,6 +479,20 @@ func (m *GetAccountStateRequest) GetBlock() string {
 	return ""
 }
 
+func (m *GetAccountStateRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetAccountStateRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // Response message of GetAccountState rpc.
 type GetAccountStateResponse struct {
 	// Current balance in unit of 1/(10^18) nas.
@@ -495,7 +545,7 @@ type TransactionRequest struct {
 	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
 	// Amount of value sending with this transaction.
 	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	// Transaction nonce.
+	// Transaction nonce, 0 to follow the pooled txs of the sender.
 	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// gasPrice sending with this transaction.
 	GasPrice string `protobuf:"bytes,5,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
@@ -716,6 +766,141 @@ func (m *SendTransactionResponse) GetResult() bool {
 	return false
 }
 
+// Request message of Call rpc.
+type CallRequest struct {
+	// Hex string of the sender account addresss.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Hex string of the contract account addresss.
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Amount of value sending with this call.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// Call nonce.
+	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// gasPrice sending with this call.
+	GasPrice string `protobuf:"bytes,5,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// gasLimit sending with this call.
+	GasLimit string `protobuf:"bytes,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// contract function to call.
+	Contract *ContractRequest `protobuf:"bytes,7,opt,name=contract" json:"contract,omitempty"`
+	// Height of the block whose state the call runs on, 0 for the tail block.
+	Height uint64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	// Hex string of the block hash whose state the call runs on, takes precedence over height.
+	BlockHash string `protobuf:"bytes,9,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+}
+
+func (m *CallRequest) Reset()                    { *m = CallRequest{} }
+func (m *CallRequest) String() string            { return proto.CompactTextString(m) }
+func (*CallRequest) ProtoMessage()               {}
+func (*CallRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{19} }
+
+func (m *CallRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *CallRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *CallRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *CallRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *CallRequest) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *CallRequest) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
+	}
+	return ""
+}
+
+func (m *CallRequest) GetContract() *ContractRequest {
+	if m != nil {
+		return m.Contract
+	}
+	return nil
+}
+
+func (m *CallRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CallRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+// Response message of Call rpc.
+type CallResponse struct {
+	// Gas used by the call.
+	GasUsed string `protobuf:"bytes,1,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// Error message of the failed call execution.
+	ExecuteError string `protobuf:"bytes,2,opt,name=execute_error,json=executeError,proto3" json:"execute_error,omitempty"`
+	// Events triggered by the call.
+	Events []*Event `protobuf:"bytes,3,rep,name=events" json:"events,omitempty"`
+	// Json-serialized result returned by the contract function.
+	Result string `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *CallResponse) Reset()                    { *m = CallResponse{} }
+func (m *CallResponse) String() string            { return proto.CompactTextString(m) }
+func (*CallResponse) ProtoMessage()               {}
+func (*CallResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{20} }
+
+func (m *CallResponse) GetGasUsed() string {
+	if m != nil {
+		return m.GasUsed
+	}
+	return ""
+}
+
+func (m *CallResponse) GetExecuteError() string {
+	if m != nil {
+		return m.ExecuteError
+	}
+	return ""
+}
+
+func (m *CallResponse) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *CallResponse) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
 // Request message of GetBlockByHash rpc.
 type GetBlockByHashRequest struct {
 	// Hex string of block hash.
@@ -725,7 +910,7 @@ type GetBlockByHashRequest struct {
 func (m *GetBlockByHashRequest) Reset()                    { *m = GetBlockByHashRequest{} }
 func (m *GetBlockByHashRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetBlockByHashRequest) ProtoMessage()               {}
-func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{19} }
+func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{21} }
 
 func (m *GetBlockByHashRequest) GetHash() string {
 	if m != nil {
@@ -734,6 +919,24 @@ func (m *GetBlockByHashRequest) GetHash() string {
 	return ""
 }
 
+// Request message of GetBlockByHeight rpc.
+type GetBlockByHeightRequest struct {
+	// block height in canonical chain.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetBlockByHeightRequest) Reset()                    { *m = GetBlockByHeightRequest{} }
+func (m *GetBlockByHeightRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockByHeightRequest) ProtoMessage()               {}
+func (*GetBlockByHeightRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{22} }
+
+func (m *GetBlockByHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // Request message of GetTransactionByHash rpc.
 type GetTransactionByHashRequest struct {
 	// Hex string of transaction hash.
@@ -744,7 +947,7 @@ func (m *GetTransactionByHashRequest) Reset()         { *m = GetTransactionByHas
 func (m *GetTransactionByHashRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionByHashRequest) ProtoMessage()    {}
 func (*GetTransactionByHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorApiRpc, []int{20}
+	return fileDescriptorApiRpc, []int{23}
 }
 
 func (m *GetTransactionByHashRequest) GetHash() string {
@@ -763,7 +966,7 @@ type BlockDumpRequest struct {
 func (m *BlockDumpRequest) Reset()                    { *m = BlockDumpRequest{} }
 func (m *BlockDumpRequest) String() string            { return proto.CompactTextString(m) }
 func (*BlockDumpRequest) ProtoMessage()               {}
-func (*BlockDumpRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{21} }
+func (*BlockDumpRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{24} }
 
 func (m *BlockDumpRequest) GetCount() int32 {
 	if m != nil {
@@ -781,7 +984,7 @@ type BlockDumpResponse struct {
 func (m *BlockDumpResponse) Reset()                    { *m = BlockDumpResponse{} }
 func (m *BlockDumpResponse) String() string            { return proto.CompactTextString(m) }
 func (*BlockDumpResponse) ProtoMessage()               {}
-func (*BlockDumpResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{22} }
+func (*BlockDumpResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{25} }
 
 func (m *BlockDumpResponse) GetData() string {
 	if m != nil {
@@ -804,13 +1007,25 @@ type TransactionReceiptResponse struct {
 	Data            string `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
 	ChainId         uint32 `protobuf:"varint,7,opt,name=chainId,proto3" json:"chainId,omitempty"`
 	ContractAddress string `protobuf:"bytes,8,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Hex string of the block hash which the tx is packed in.
+	BlockHash string `protobuf:"bytes,9,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// Height of the block which the tx is packed in.
+	BlockHeight uint64 `protobuf:"varint,10,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Transaction execution status, 1 for success and 0 for failure.
+	Status int32 `protobuf:"varint,11,opt,name=status,proto3" json:"status,omitempty"`
+	// Gas used by the transaction execution.
+	GasUsed string `protobuf:"bytes,12,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// Error message of the failed transaction execution.
+	ExecuteError string `protobuf:"bytes,13,opt,name=execute_error,json=executeError,proto3" json:"execute_error,omitempty"`
+	// Json-serialized result returned by the contract function.
+	ExecuteResult string `protobuf:"bytes,14,opt,name=execute_result,json=executeResult,proto3" json:"execute_result,omitempty"`
 }
 
 func (m *TransactionReceiptResponse) Reset()         { *m = TransactionReceiptResponse{} }
 func (m *TransactionReceiptResponse) String() string { return proto.CompactTextString(m) }
 func (*TransactionReceiptResponse) ProtoMessage()    {}
 func (*TransactionReceiptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorApiRpc, []int{23}
+	return fileDescriptorApiRpc, []int{26}
 }
 
 func (m *TransactionReceiptResponse) GetHash() string {
@@ -869,6 +1084,48 @@ func (m *TransactionReceiptResponse) GetContractAddress() string {
 	return ""
 }
 
+func (m *TransactionReceiptResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *TransactionReceiptResponse) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TransactionReceiptResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *TransactionReceiptResponse) GetGasUsed() string {
+	if m != nil {
+		return m.GasUsed
+	}
+	return ""
+}
+
+func (m *TransactionReceiptResponse) GetExecuteError() string {
+	if m != nil {
+		return m.ExecuteError
+	}
+	return ""
+}
+
+func (m *TransactionReceiptResponse) GetExecuteResult() string {
+	if m != nil {
+		return m.ExecuteResult
+	}
+	return ""
+}
+
 type NewAccountRequest struct {
 	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
 }
@@ -876,7 +1133,7 @@ type NewAccountRequest struct {
 func (m *NewAccountRequest) Reset()                    { *m = NewAccountRequest{} }
 func (m *NewAccountRequest) String() string            { return proto.CompactTextString(m) }
 func (*NewAccountRequest) ProtoMessage()               {}
-func (*NewAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{24} }
+func (*NewAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{27} }
 
 func (m *NewAccountRequest) GetPassphrase() string {
 	if m != nil {
@@ -892,7 +1149,7 @@ type NewAccountResponse struct {
 func (m *NewAccountResponse) Reset()                    { *m = NewAccountResponse{} }
 func (m *NewAccountResponse) String() string            { return proto.CompactTextString(m) }
 func (*NewAccountResponse) ProtoMessage()               {}
-func (*NewAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{25} }
+func (*NewAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{28} }
 
 func (m *NewAccountResponse) GetAddress() string {
 	if m != nil {
@@ -909,7 +1166,7 @@ type UnlockAccountRequest struct {
 func (m *UnlockAccountRequest) Reset()                    { *m = UnlockAccountRequest{} }
 func (m *UnlockAccountRequest) String() string            { return proto.CompactTextString(m) }
 func (*UnlockAccountRequest) ProtoMessage()               {}
-func (*UnlockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{26} }
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{29} }
 
 func (m *UnlockAccountRequest) GetAddress() string {
 	if m != nil {
@@ -932,7 +1189,7 @@ type UnlockAccountResponse struct {
 func (m *UnlockAccountResponse) Reset()                    { *m = UnlockAccountResponse{} }
 func (m *UnlockAccountResponse) String() string            { return proto.CompactTextString(m) }
 func (*UnlockAccountResponse) ProtoMessage()               {}
-func (*UnlockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{27} }
+func (*UnlockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{30} }
 
 func (m *UnlockAccountResponse) GetResult() bool {
 	if m != nil {
@@ -948,7 +1205,7 @@ type LockAccountRequest struct {
 func (m *LockAccountRequest) Reset()                    { *m = LockAccountRequest{} }
 func (m *LockAccountRequest) String() string            { return proto.CompactTextString(m) }
 func (*LockAccountRequest) ProtoMessage()               {}
-func (*LockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{28} }
+func (*LockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{31} }
 
 func (m *LockAccountRequest) GetAddress() string {
 	if m != nil {
@@ -964,7 +1221,7 @@ type LockAccountResponse struct {
 func (m *LockAccountResponse) Reset()                    { *m = LockAccountResponse{} }
 func (m *LockAccountResponse) String() string            { return proto.CompactTextString(m) }
 func (*LockAccountResponse) ProtoMessage()               {}
-func (*LockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{29} }
+func (*LockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{32} }
 
 func (m *LockAccountResponse) GetResult() bool {
 	if m != nil {
@@ -980,7 +1237,7 @@ type SignTransactionResponse struct {
 func (m *SignTransactionResponse) Reset()                    { *m = SignTransactionResponse{} }
 func (m *SignTransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*SignTransactionResponse) ProtoMessage()               {}
-func (*SignTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{30} }
+func (*SignTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{33} }
 
 func (m *SignTransactionResponse) GetData() []byte {
 	if m != nil {
@@ -1000,7 +1257,7 @@ func (m *SendTransactionPassphraseRequest) Reset()         { *m = SendTransactio
 func (m *SendTransactionPassphraseRequest) String() string { return proto.CompactTextString(m) }
 func (*SendTransactionPassphraseRequest) ProtoMessage()    {}
 func (*SendTransactionPassphraseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorApiRpc, []int{31}
+	return fileDescriptorApiRpc, []int{34}
 }
 
 func (m *SendTransactionPassphraseRequest) GetTransaction() *TransactionRequest {
@@ -1026,7 +1283,7 @@ func (m *SendTransactionPassphraseResponse) Reset()         { *m = SendTransacti
 func (m *SendTransactionPassphraseResponse) String() string { return proto.CompactTextString(m) }
 func (*SendTransactionPassphraseResponse) ProtoMessage()    {}
 func (*SendTransactionPassphraseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorApiRpc, []int{32}
+	return fileDescriptorApiRpc, []int{35}
 }
 
 func (m *SendTransactionPassphraseResponse) GetHash() string {
@@ -1037,13 +1294,20 @@ func (m *SendTransactionPassphraseResponse) GetHash() string {
 }
 
 type GasPriceResponse struct {
+	// Suggested gasPrice at the configured percentile of recent blocks.
 	GasPrice string `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// Low gasPrice at the 25th percentile of recent blocks.
+	Low string `protobuf:"bytes,2,opt,name=low,proto3" json:"low,omitempty"`
+	// Median gasPrice of recent blocks.
+	Median string `protobuf:"bytes,3,opt,name=median,proto3" json:"median,omitempty"`
+	// High gasPrice at the 75th percentile of recent blocks.
+	High string `protobuf:"bytes,4,opt,name=high,proto3" json:"high,omitempty"`
 }
 
 func (m *GasPriceResponse) Reset()                    { *m = GasPriceResponse{} }
 func (m *GasPriceResponse) String() string            { return proto.CompactTextString(m) }
 func (*GasPriceResponse) ProtoMessage()               {}
-func (*GasPriceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{33} }
+func (*GasPriceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{36} }
 
 func (m *GasPriceResponse) GetGasPrice() string {
 	if m != nil {
@@ -1052,14 +1316,39 @@ func (m *GasPriceResponse) GetGasPrice() string {
 	return ""
 }
 
+func (m *GasPriceResponse) GetLow() string {
+	if m != nil {
+		return m.Low
+	}
+	return ""
+}
+
+func (m *GasPriceResponse) GetMedian() string {
+	if m != nil {
+		return m.Median
+	}
+	return ""
+}
+
+func (m *GasPriceResponse) GetHigh() string {
+	if m != nil {
+		return m.High
+	}
+	return ""
+}
+
 type EstimateGasResponse struct {
 	EstimateGas string `protobuf:"bytes,1,opt,name=estimate_gas,json=estimateGas,proto3" json:"estimate_gas,omitempty"`
+	// Whether the transaction would fail.
+	Failed bool `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Error message of the failed execution.
+	ExecuteError string `protobuf:"bytes,3,opt,name=execute_error,json=executeError,proto3" json:"execute_error,omitempty"`
 }
 
 func (m *EstimateGasResponse) Reset()                    { *m = EstimateGasResponse{} }
 func (m *EstimateGasResponse) String() string            { return proto.CompactTextString(m) }
 func (*EstimateGasResponse) ProtoMessage()               {}
-func (*EstimateGasResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{34} }
+func (*EstimateGasResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{37} }
 
 func (m *EstimateGasResponse) GetEstimateGas() string {
 	if m != nil {
@@ -1068,6 +1357,20 @@ func (m *EstimateGasResponse) GetEstimateGas() string {
 	return ""
 }
 
+func (m *EstimateGasResponse) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+func (m *EstimateGasResponse) GetExecuteError() string {
+	if m != nil {
+		return m.ExecuteError
+	}
+	return ""
+}
+
 type EventsResponse struct {
 	Events []*Event `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
 }
@@ -1075,7 +1378,7 @@ type EventsResponse struct {
 func (m *EventsResponse) Reset()                    { *m = EventsResponse{} }
 func (m *EventsResponse) String() string            { return proto.CompactTextString(m) }
 func (*EventsResponse) ProtoMessage()               {}
-func (*EventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{35} }
+func (*EventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{38} }
 
 func (m *EventsResponse) GetEvents() []*Event {
 	if m != nil {
@@ -1092,7 +1395,7 @@ type Event struct {
 func (m *Event) Reset()                    { *m = Event{} }
 func (m *Event) String() string            { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()               {}
-func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{36} }
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{39} }
 
 func (m *Event) GetTopic() string {
 	if m != nil {
@@ -1108,87 +1411,563 @@ func (m *Event) GetData() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*SubscribeRequest)(nil), "rpcpb.SubscribeRequest")
-	proto.RegisterType((*ChangeNetworkIDRequest)(nil), "rpcpb.ChangeNetworkIDRequest")
-	proto.RegisterType((*ChangeNetworkIDResponse)(nil), "rpcpb.ChangeNetworkIDResponse")
-	proto.RegisterType((*SubscribeResponse)(nil), "rpcpb.SubscribeResponse")
-	proto.RegisterType((*NonParamsRequest)(nil), "rpcpb.NonParamsRequest")
-	proto.RegisterType((*NodeInfoResponse)(nil), "rpcpb.NodeInfoResponse")
-	proto.RegisterType((*StatisticsNodeInfoResponse)(nil), "rpcpb.StatisticsNodeInfoResponse")
-	proto.RegisterType((*RouteTable)(nil), "rpcpb.RouteTable")
-	proto.RegisterType((*GetNebStateResponse)(nil), "rpcpb.GetNebStateResponse")
-	proto.RegisterType((*AccountsResponse)(nil), "rpcpb.AccountsResponse")
-	proto.RegisterType((*GetAccountStateRequest)(nil), "rpcpb.GetAccountStateRequest")
-	proto.RegisterType((*GetAccountStateResponse)(nil), "rpcpb.GetAccountStateResponse")
-	proto.RegisterType((*GetDynastyResponse)(nil), "rpcpb.GetDynastyResponse")
-	proto.RegisterType((*TransactionRequest)(nil), "rpcpb.TransactionRequest")
-	proto.RegisterType((*ContractRequest)(nil), "rpcpb.ContractRequest")
-	proto.RegisterType((*CandidateRequest)(nil), "rpcpb.CandidateRequest")
-	proto.RegisterType((*DelegateRequest)(nil), "rpcpb.DelegateRequest")
-	proto.RegisterType((*SendRawTransactionRequest)(nil), "rpcpb.SendRawTransactionRequest")
-	proto.RegisterType((*SendTransactionResponse)(nil), "rpcpb.SendTransactionResponse")
-	proto.RegisterType((*GetBlockByHashRequest)(nil), "rpcpb.GetBlockByHashRequest")
-	proto.RegisterType((*GetTransactionByHashRequest)(nil), "rpcpb.GetTransactionByHashRequest")
-	proto.RegisterType((*BlockDumpRequest)(nil), "rpcpb.BlockDumpRequest")
-	proto.RegisterType((*BlockDumpResponse)(nil), "rpcpb.BlockDumpResponse")
-	proto.RegisterType((*TransactionReceiptResponse)(nil), "rpcpb.TransactionReceiptResponse")
-	proto.RegisterType((*NewAccountRequest)(nil), "rpcpb.NewAccountRequest")
-	proto.RegisterType((*NewAccountResponse)(nil), "rpcpb.NewAccountResponse")
-	proto.RegisterType((*UnlockAccountRequest)(nil), "rpcpb.UnlockAccountRequest")
-	proto.RegisterType((*UnlockAccountResponse)(nil), "rpcpb.UnlockAccountResponse")
-	proto.RegisterType((*LockAccountRequest)(nil), "rpcpb.LockAccountRequest")
-	proto.RegisterType((*LockAccountResponse)(nil), "rpcpb.LockAccountResponse")
-	proto.RegisterType((*SignTransactionResponse)(nil), "rpcpb.SignTransactionResponse")
-	proto.RegisterType((*SendTransactionPassphraseRequest)(nil), "rpcpb.SendTransactionPassphraseRequest")
-	proto.RegisterType((*SendTransactionPassphraseResponse)(nil), "rpcpb.SendTransactionPassphraseResponse")
-	proto.RegisterType((*GasPriceResponse)(nil), "rpcpb.GasPriceResponse")
-	proto.RegisterType((*EstimateGasResponse)(nil), "rpcpb.EstimateGasResponse")
-	proto.RegisterType((*EventsResponse)(nil), "rpcpb.EventsResponse")
-	proto.RegisterType((*Event)(nil), "rpcpb.Event")
+// Request message of GetAccountProof rpc.
+type GetAccountProofRequest struct {
+	// Hex string of the account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Hex string of block hash, takes precedence over height.
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block height in canonical chain, 0 means the tail block.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for ApiService service
+func (m *GetAccountProofRequest) Reset()                    { *m = GetAccountProofRequest{} }
+func (m *GetAccountProofRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetAccountProofRequest) ProtoMessage()               {}
+func (*GetAccountProofRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{40} }
 
-type ApiServiceClient interface {
-	// Return the state of the neb.
-	GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error)
-	// Return the p2p node info.
-	NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
-	// Return the dump info of blockchain.
-	BlockDump(ctx context.Context, in *BlockDumpRequest, opts ...grpc.CallOption) (*BlockDumpResponse, error)
-	// Accounts return account list.
-	Accounts(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
-	// Return the state of the account.
-	GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error)
-	// Verify, sign, and send the transaction.
-	SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
-	// Call smart contract.
-	Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
-	// Submit the signed transaction.
-	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
-	// Get block header info by the block hash.
-	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*corepb.Block, error)
-	// Get transactionReceipt info by tansaction hash.
-	GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionReceiptResponse, error)
-	// Subscribe message
-	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
-	// Get GasPrice
-	GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
-	// EstimateGas
-	EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
-	GetEventsByHash(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*EventsResponse, error)
+func (m *GetAccountProofRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type apiServiceClient struct {
+func (m *GetAccountProofRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetAccountProofRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetAccountProof rpc.
+type AccountProofResponse struct {
+	// Hex string of the block the proof is taken from.
+	BlockHash string `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// Hex string of the block's state root.
+	StateRoot string `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// Serialized account.
+	Account []byte `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// Serialized trie nodes from the state root down to the account.
+	Proof [][]byte `protobuf:"bytes,4,rep,name=proof" json:"proof,omitempty"`
+}
+
+func (m *AccountProofResponse) Reset()                    { *m = AccountProofResponse{} }
+func (m *AccountProofResponse) String() string            { return proto.CompactTextString(m) }
+func (*AccountProofResponse) ProtoMessage()               {}
+func (*AccountProofResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{41} }
+
+func (m *AccountProofResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *AccountProofResponse) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
+func (m *AccountProofResponse) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *AccountProofResponse) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// Request message of GetStorageProof rpc.
+type GetStorageProofRequest struct {
+	// Hex string of the contract address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Storage key as used by the contract.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Hex string of block hash, takes precedence over height.
+	BlockHash string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block height in canonical chain, 0 means the tail block.
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetStorageProofRequest) Reset()                    { *m = GetStorageProofRequest{} }
+func (m *GetStorageProofRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetStorageProofRequest) ProtoMessage()               {}
+func (*GetStorageProofRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{42} }
+
+func (m *GetStorageProofRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetStorageProofRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetStorageProofRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetStorageProofRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetStorageProof rpc.
+type StorageProofResponse struct {
+	// Hex string of the block the proof is taken from.
+	BlockHash string `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// Hex string of the block's state root.
+	StateRoot string `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// Serialized contract account.
+	Account []byte `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// Serialized trie nodes from the state root down to the account.
+	AccountProof [][]byte `protobuf:"bytes,4,rep,name=account_proof,json=accountProof" json:"account_proof,omitempty"`
+	// Hex string of the hashed key in the contract storage trie.
+	StorageKey string `protobuf:"bytes,5,opt,name=storage_key,json=storageKey,proto3" json:"storage_key,omitempty"`
+	// Stored value.
+	Value []byte `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	// Serialized trie nodes from the storage root down to the value.
+	StorageProof [][]byte `protobuf:"bytes,7,rep,name=storage_proof,json=storageProof" json:"storage_proof,omitempty"`
+}
+
+func (m *StorageProofResponse) Reset()                    { *m = StorageProofResponse{} }
+func (m *StorageProofResponse) String() string            { return proto.CompactTextString(m) }
+func (*StorageProofResponse) ProtoMessage()               {}
+func (*StorageProofResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{43} }
+
+func (m *StorageProofResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *StorageProofResponse) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
+func (m *StorageProofResponse) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *StorageProofResponse) GetAccountProof() [][]byte {
+	if m != nil {
+		return m.AccountProof
+	}
+	return nil
+}
+
+func (m *StorageProofResponse) GetStorageKey() string {
+	if m != nil {
+		return m.StorageKey
+	}
+	return ""
+}
+
+func (m *StorageProofResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StorageProofResponse) GetStorageProof() [][]byte {
+	if m != nil {
+		return m.StorageProof
+	}
+	return nil
+}
+
+// Response message of GetTransactionProof rpc.
+type TransactionProofResponse struct {
+	// Hex string of the block containing the transaction.
+	BlockHash string `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// Hex string of the block's txs root.
+	TxsRoot string `protobuf:"bytes,2,opt,name=txs_root,json=txsRoot,proto3" json:"txs_root,omitempty"`
+	// Serialized transaction.
+	Transaction []byte `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// Serialized trie nodes from the txs root down to the transaction.
+	Proof [][]byte `protobuf:"bytes,4,rep,name=proof" json:"proof,omitempty"`
+}
+
+func (m *TransactionProofResponse) Reset()                    { *m = TransactionProofResponse{} }
+func (m *TransactionProofResponse) String() string            { return proto.CompactTextString(m) }
+func (*TransactionProofResponse) ProtoMessage()               {}
+func (*TransactionProofResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{44} }
+
+func (m *TransactionProofResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *TransactionProofResponse) GetTxsRoot() string {
+	if m != nil {
+		return m.TxsRoot
+	}
+	return ""
+}
+
+func (m *TransactionProofResponse) GetTransaction() []byte {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *TransactionProofResponse) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// Request message of GetContractStorage rpc.
+type GetContractStorageRequest struct {
+	// Hex string of the contract address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Storage key as used by the contract.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Hex string of block hash, takes precedence over height.
+	BlockHash string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block height in canonical chain, 0 means the tail block.
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetContractStorageRequest) Reset()                    { *m = GetContractStorageRequest{} }
+func (m *GetContractStorageRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetContractStorageRequest) ProtoMessage()               {}
+func (*GetContractStorageRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{45} }
+
+func (m *GetContractStorageRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetContractStorageRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetContractStorageRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetContractStorageRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetContractStorage rpc.
+type GetContractStorageResponse struct {
+	// Json-serialized value stored by the contract.
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *GetContractStorageResponse) Reset()         { *m = GetContractStorageResponse{} }
+func (m *GetContractStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*GetContractStorageResponse) ProtoMessage()    {}
+func (*GetContractStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{46}
+}
+
+func (m *GetContractStorageResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// Response message of GetTxPoolStatus rpc.
+type TxPoolStatusResponse struct {
+	// Count of txs in pool.
+	Count uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Count of senders having txs in pool.
+	Accounts uint32 `protobuf:"varint,2,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	// Maximum count of txs in pool.
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Count of txs a sender can keep in a full pool.
+	AccountSlots uint32 `protobuf:"varint,4,opt,name=account_slots,json=accountSlots,proto3" json:"account_slots,omitempty"`
+	// Maximum seconds a tx stays in pool.
+	Lifetime uint64 `protobuf:"varint,5,opt,name=lifetime,proto3" json:"lifetime,omitempty"`
+	// Count of executable txs in pool.
+	Pending uint32 `protobuf:"varint,6,opt,name=pending,proto3" json:"pending,omitempty"`
+	// Count of txs waiting for a nonce gap to close.
+	Queued uint32 `protobuf:"varint,7,opt,name=queued,proto3" json:"queued,omitempty"`
+}
+
+func (m *TxPoolStatusResponse) Reset()                    { *m = TxPoolStatusResponse{} }
+func (m *TxPoolStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*TxPoolStatusResponse) ProtoMessage()               {}
+func (*TxPoolStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{47} }
+
+func (m *TxPoolStatusResponse) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *TxPoolStatusResponse) GetAccounts() uint32 {
+	if m != nil {
+		return m.Accounts
+	}
+	return 0
+}
+
+func (m *TxPoolStatusResponse) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *TxPoolStatusResponse) GetAccountSlots() uint32 {
+	if m != nil {
+		return m.AccountSlots
+	}
+	return 0
+}
+
+func (m *TxPoolStatusResponse) GetLifetime() uint64 {
+	if m != nil {
+		return m.Lifetime
+	}
+	return 0
+}
+
+func (m *TxPoolStatusResponse) GetPending() uint32 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *TxPoolStatusResponse) GetQueued() uint32 {
+	if m != nil {
+		return m.Queued
+	}
+	return 0
+}
+
+// Request message of the transaction pool rpcs of an address.
+type PoolAccountRequest struct {
+	// Hex string of the account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *PoolAccountRequest) Reset()                    { *m = PoolAccountRequest{} }
+func (m *PoolAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*PoolAccountRequest) ProtoMessage()               {}
+func (*PoolAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{48} }
+
+func (m *PoolAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// Response message of GetPendingTransactions rpc.
+type PendingTransactionsResponse struct {
+	// Executable txs sorted by nonce.
+	Pending []*corepb.Transaction `protobuf:"bytes,1,rep,name=pending" json:"pending,omitempty"`
+	// Txs waiting for a nonce gap to close, sorted by nonce.
+	Queued []*corepb.Transaction `protobuf:"bytes,2,rep,name=queued" json:"queued,omitempty"`
+}
+
+func (m *PendingTransactionsResponse) Reset()         { *m = PendingTransactionsResponse{} }
+func (m *PendingTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingTransactionsResponse) ProtoMessage()    {}
+func (*PendingTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{49}
+}
+
+func (m *PendingTransactionsResponse) GetPending() []*corepb.Transaction {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func (m *PendingTransactionsResponse) GetQueued() []*corepb.Transaction {
+	if m != nil {
+		return m.Queued
+	}
+	return nil
+}
+
+// Response message of GetNextNonce rpc.
+type NextNonceResponse struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *NextNonceResponse) Reset()                    { *m = NextNonceResponse{} }
+func (m *NextNonceResponse) String() string            { return proto.CompactTextString(m) }
+func (*NextNonceResponse) ProtoMessage()               {}
+func (*NextNonceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{50} }
+
+func (m *NextNonceResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "rpcpb.SubscribeRequest")
+	proto.RegisterType((*ChangeNetworkIDRequest)(nil), "rpcpb.ChangeNetworkIDRequest")
+	proto.RegisterType((*ChangeNetworkIDResponse)(nil), "rpcpb.ChangeNetworkIDResponse")
+	proto.RegisterType((*SubscribeResponse)(nil), "rpcpb.SubscribeResponse")
+	proto.RegisterType((*NonParamsRequest)(nil), "rpcpb.NonParamsRequest")
+	proto.RegisterType((*NodeInfoResponse)(nil), "rpcpb.NodeInfoResponse")
+	proto.RegisterType((*StatisticsNodeInfoResponse)(nil), "rpcpb.StatisticsNodeInfoResponse")
+	proto.RegisterType((*RouteTable)(nil), "rpcpb.RouteTable")
+	proto.RegisterType((*GetNebStateResponse)(nil), "rpcpb.GetNebStateResponse")
+	proto.RegisterType((*AccountsResponse)(nil), "rpcpb.AccountsResponse")
+	proto.RegisterType((*GetAccountStateRequest)(nil), "rpcpb.GetAccountStateRequest")
+	proto.RegisterType((*GetAccountStateResponse)(nil), "rpcpb.GetAccountStateResponse")
+	proto.RegisterType((*GetDynastyResponse)(nil), "rpcpb.GetDynastyResponse")
+	proto.RegisterType((*TransactionRequest)(nil), "rpcpb.TransactionRequest")
+	proto.RegisterType((*ContractRequest)(nil), "rpcpb.ContractRequest")
+	proto.RegisterType((*CandidateRequest)(nil), "rpcpb.CandidateRequest")
+	proto.RegisterType((*DelegateRequest)(nil), "rpcpb.DelegateRequest")
+	proto.RegisterType((*SendRawTransactionRequest)(nil), "rpcpb.SendRawTransactionRequest")
+	proto.RegisterType((*SendTransactionResponse)(nil), "rpcpb.SendTransactionResponse")
+	proto.RegisterType((*CallRequest)(nil), "rpcpb.CallRequest")
+	proto.RegisterType((*CallResponse)(nil), "rpcpb.CallResponse")
+	proto.RegisterType((*GetBlockByHashRequest)(nil), "rpcpb.GetBlockByHashRequest")
+	proto.RegisterType((*GetBlockByHeightRequest)(nil), "rpcpb.GetBlockByHeightRequest")
+	proto.RegisterType((*GetTransactionByHashRequest)(nil), "rpcpb.GetTransactionByHashRequest")
+	proto.RegisterType((*BlockDumpRequest)(nil), "rpcpb.BlockDumpRequest")
+	proto.RegisterType((*BlockDumpResponse)(nil), "rpcpb.BlockDumpResponse")
+	proto.RegisterType((*TransactionReceiptResponse)(nil), "rpcpb.TransactionReceiptResponse")
+	proto.RegisterType((*NewAccountRequest)(nil), "rpcpb.NewAccountRequest")
+	proto.RegisterType((*NewAccountResponse)(nil), "rpcpb.NewAccountResponse")
+	proto.RegisterType((*UnlockAccountRequest)(nil), "rpcpb.UnlockAccountRequest")
+	proto.RegisterType((*UnlockAccountResponse)(nil), "rpcpb.UnlockAccountResponse")
+	proto.RegisterType((*LockAccountRequest)(nil), "rpcpb.LockAccountRequest")
+	proto.RegisterType((*LockAccountResponse)(nil), "rpcpb.LockAccountResponse")
+	proto.RegisterType((*SignTransactionResponse)(nil), "rpcpb.SignTransactionResponse")
+	proto.RegisterType((*SendTransactionPassphraseRequest)(nil), "rpcpb.SendTransactionPassphraseRequest")
+	proto.RegisterType((*SendTransactionPassphraseResponse)(nil), "rpcpb.SendTransactionPassphraseResponse")
+	proto.RegisterType((*GasPriceResponse)(nil), "rpcpb.GasPriceResponse")
+	proto.RegisterType((*EstimateGasResponse)(nil), "rpcpb.EstimateGasResponse")
+	proto.RegisterType((*EventsResponse)(nil), "rpcpb.EventsResponse")
+	proto.RegisterType((*Event)(nil), "rpcpb.Event")
+	proto.RegisterType((*GetAccountProofRequest)(nil), "rpcpb.GetAccountProofRequest")
+	proto.RegisterType((*AccountProofResponse)(nil), "rpcpb.AccountProofResponse")
+	proto.RegisterType((*GetStorageProofRequest)(nil), "rpcpb.GetStorageProofRequest")
+	proto.RegisterType((*StorageProofResponse)(nil), "rpcpb.StorageProofResponse")
+	proto.RegisterType((*TransactionProofResponse)(nil), "rpcpb.TransactionProofResponse")
+	proto.RegisterType((*GetContractStorageRequest)(nil), "rpcpb.GetContractStorageRequest")
+	proto.RegisterType((*GetContractStorageResponse)(nil), "rpcpb.GetContractStorageResponse")
+	proto.RegisterType((*TxPoolStatusResponse)(nil), "rpcpb.TxPoolStatusResponse")
+	proto.RegisterType((*PoolAccountRequest)(nil), "rpcpb.PoolAccountRequest")
+	proto.RegisterType((*PendingTransactionsResponse)(nil), "rpcpb.PendingTransactionsResponse")
+	proto.RegisterType((*NextNonceResponse)(nil), "rpcpb.NextNonceResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ApiService service
+
+type ApiServiceClient interface {
+	// Return the state of the neb.
+	GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error)
+	// Return the p2p node info.
+	NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
+	// Return the dump info of blockchain.
+	BlockDump(ctx context.Context, in *BlockDumpRequest, opts ...grpc.CallOption) (*BlockDumpResponse, error)
+	// Accounts return account list.
+	Accounts(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
+	// Return the state of the account.
+	GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error)
+	// Verify, sign, and send the transaction.
+	SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
+	// Simulate the smart contract call on chain state, nothing is broadcasted.
+	Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error)
+	// Submit the signed transaction.
+	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
+	// Get block header info by the block hash.
+	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*corepb.Block, error)
+	// Get block header info by the block height in canonical chain.
+	GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*corepb.Block, error)
+	// Get transactionReceipt info by tansaction hash.
+	GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionReceiptResponse, error)
+	// Subscribe message
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
+	// Get GasPrice
+	GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
+	// EstimateGas
+	EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	GetEventsByHash(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*EventsResponse, error)
+	// Return the merkle proof of an account in the state trie.
+	GetAccountProof(ctx context.Context, in *GetAccountProofRequest, opts ...grpc.CallOption) (*AccountProofResponse, error)
+	// Return the merkle proofs of a contract storage item.
+	GetStorageProof(ctx context.Context, in *GetStorageProofRequest, opts ...grpc.CallOption) (*StorageProofResponse, error)
+	// Return the merkle proof of a transaction in its block's txs trie.
+	GetTransactionProof(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionProofResponse, error)
+	// Return the value of a contract storage key.
+	GetContractStorage(ctx context.Context, in *GetContractStorageRequest, opts ...grpc.CallOption) (*GetContractStorageResponse, error)
+	// Return the status and limits of the transaction pool.
+	GetTxPoolStatus(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*TxPoolStatusResponse, error)
+	// Return the pending and queued txs of an address in the transaction pool.
+	GetPendingTransactions(ctx context.Context, in *PoolAccountRequest, opts ...grpc.CallOption) (*PendingTransactionsResponse, error)
+	// Return a tx in the transaction pool by hash.
+	GetPooledTransaction(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*corepb.Transaction, error)
+	// Return the nonce of the next tx of an address, following its pending txs.
+	GetNextNonce(ctx context.Context, in *PoolAccountRequest, opts ...grpc.CallOption) (*NextNonceResponse, error)
+}
+
+type apiServiceClient struct {
 	cc *grpc.ClientConn
 }
 
@@ -1250,8 +2029,8 @@ func (c *apiServiceClient) SendTransaction(ctx context.Context, in *TransactionR
 	return out, nil
 }
 
-func (c *apiServiceClient) Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
-	out := new(SendTransactionResponse)
+func (c *apiServiceClient) Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error) {
+	out := new(CallResponse)
 	err := grpc.Invoke(ctx, "/rpcpb.ApiService/Call", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -1277,6 +2056,15 @@ func (c *apiServiceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHas
 	return out, nil
 }
 
+func (c *apiServiceClient) GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*corepb.Block, error) {
+	out := new(corepb.Block)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHeight", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionReceiptResponse, error) {
 	out := new(TransactionReceiptResponse)
 	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTransactionReceipt", in, out, c.cc, opts...)
@@ -1345,6 +2133,78 @@ func (c *apiServiceClient) GetEventsByHash(ctx context.Context, in *GetTransacti
 	return out, nil
 }
 
+func (c *apiServiceClient) GetAccountProof(ctx context.Context, in *GetAccountProofRequest, opts ...grpc.CallOption) (*AccountProofResponse, error) {
+	out := new(AccountProofResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetAccountProof", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetStorageProof(ctx context.Context, in *GetStorageProofRequest, opts ...grpc.CallOption) (*StorageProofResponse, error) {
+	out := new(StorageProofResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetStorageProof", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTransactionProof(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionProofResponse, error) {
+	out := new(TransactionProofResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTransactionProof", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetContractStorage(ctx context.Context, in *GetContractStorageRequest, opts ...grpc.CallOption) (*GetContractStorageResponse, error) {
+	out := new(GetContractStorageResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetContractStorage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTxPoolStatus(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*TxPoolStatusResponse, error) {
+	out := new(TxPoolStatusResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTxPoolStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetPendingTransactions(ctx context.Context, in *PoolAccountRequest, opts ...grpc.CallOption) (*PendingTransactionsResponse, error) {
+	out := new(PendingTransactionsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetPendingTransactions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetPooledTransaction(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*corepb.Transaction, error) {
+	out := new(corepb.Transaction)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetPooledTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetNextNonce(ctx context.Context, in *PoolAccountRequest, opts ...grpc.CallOption) (*NextNonceResponse, error) {
+	out := new(NextNonceResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetNextNonce", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ApiService service
 
 type ApiServiceServer interface {
@@ -1360,12 +2220,14 @@ type ApiServiceServer interface {
 	GetAccountState(context.Context, *GetAccountStateRequest) (*GetAccountStateResponse, error)
 	// Verify, sign, and send the transaction.
 	SendTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
-	// Call smart contract.
-	Call(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
+	// Simulate the smart contract call on chain state, nothing is broadcasted.
+	Call(context.Context, *CallRequest) (*CallResponse, error)
 	// Submit the signed transaction.
 	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendTransactionResponse, error)
 	// Get block header info by the block hash.
 	GetBlockByHash(context.Context, *GetBlockByHashRequest) (*corepb.Block, error)
+	// Get block header info by the block height in canonical chain.
+	GetBlockByHeight(context.Context, *GetBlockByHeightRequest) (*corepb.Block, error)
 	// Get transactionReceipt info by tansaction hash.
 	GetTransactionReceipt(context.Context, *GetTransactionByHashRequest) (*TransactionReceiptResponse, error)
 	// Subscribe message
@@ -1375,6 +2237,22 @@ type ApiServiceServer interface {
 	// EstimateGas
 	EstimateGas(context.Context, *TransactionRequest) (*EstimateGasResponse, error)
 	GetEventsByHash(context.Context, *GetTransactionByHashRequest) (*EventsResponse, error)
+	// Return the merkle proof of an account in the state trie.
+	GetAccountProof(context.Context, *GetAccountProofRequest) (*AccountProofResponse, error)
+	// Return the merkle proofs of a contract storage item.
+	GetStorageProof(context.Context, *GetStorageProofRequest) (*StorageProofResponse, error)
+	// Return the merkle proof of a transaction in its block's txs trie.
+	GetTransactionProof(context.Context, *GetTransactionByHashRequest) (*TransactionProofResponse, error)
+	// Return the value of a contract storage key.
+	GetContractStorage(context.Context, *GetContractStorageRequest) (*GetContractStorageResponse, error)
+	// Return the status and limits of the transaction pool.
+	GetTxPoolStatus(context.Context, *NonParamsRequest) (*TxPoolStatusResponse, error)
+	// Return the pending and queued txs of an address in the transaction pool.
+	GetPendingTransactions(context.Context, *PoolAccountRequest) (*PendingTransactionsResponse, error)
+	// Return a tx in the transaction pool by hash.
+	GetPooledTransaction(context.Context, *GetTransactionByHashRequest) (*corepb.Transaction, error)
+	// Return the nonce of the next tx of an address, following its pending txs.
+	GetNextNonce(context.Context, *PoolAccountRequest) (*NextNonceResponse, error)
 }
 
 func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
@@ -1490,7 +2368,7 @@ func _ApiService_SendTransaction_Handler(srv interface{}, ctx context.Context, d
 }
 
 func _ApiService_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransactionRequest)
+	in := new(CallRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1502,7 +2380,7 @@ func _ApiService_Call_Handler(srv interface{}, ctx context.Context, dec func(int
 		FullMethod: "/rpcpb.ApiService/Call",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).Call(ctx, req.(*TransactionRequest))
+		return srv.(ApiServiceServer).Call(ctx, req.(*CallRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1543,6 +2421,24 @@ func _ApiService_GetBlockByHash_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetBlockByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockByHeight(ctx, req.(*GetBlockByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetTransactionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTransactionByHashRequest)
 	if err := dec(in); err != nil {
@@ -1636,6 +2532,150 @@ func _ApiService_GetEventsByHash_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetAccountProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAccountProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetAccountProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAccountProof(ctx, req.(*GetAccountProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetStorageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetStorageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetStorageProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetStorageProof(ctx, req.(*GetStorageProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTransactionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTransactionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTransactionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTransactionProof(ctx, req.(*GetTransactionByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetContractStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetContractStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetContractStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetContractStorage(ctx, req.(*GetContractStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTxPoolStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxPoolStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxPoolStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxPoolStatus(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetPendingTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetPendingTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetPendingTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetPendingTransactions(ctx, req.(*PoolAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetPooledTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetPooledTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetPooledTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetPooledTransaction(ctx, req.(*GetTransactionByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetNextNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetNextNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetNextNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetNextNonce(ctx, req.(*PoolAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApiService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.ApiService",
 	HandlerType: (*ApiServiceServer)(nil),
@@ -1676,6 +2716,10 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockByHash",
 			Handler:    _ApiService_GetBlockByHash_Handler,
 		},
+		{
+			MethodName: "GetBlockByHeight",
+			Handler:    _ApiService_GetBlockByHeight_Handler,
+		},
 		{
 			MethodName: "GetTransactionReceipt",
 			Handler:    _ApiService_GetTransactionReceipt_Handler,
@@ -1692,6 +2736,38 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetEventsByHash",
 			Handler:    _ApiService_GetEventsByHash_Handler,
 		},
+		{
+			MethodName: "GetAccountProof",
+			Handler:    _ApiService_GetAccountProof_Handler,
+		},
+		{
+			MethodName: "GetStorageProof",
+			Handler:    _ApiService_GetStorageProof_Handler,
+		},
+		{
+			MethodName: "GetTransactionProof",
+			Handler:    _ApiService_GetTransactionProof_Handler,
+		},
+		{
+			MethodName: "GetContractStorage",
+			Handler:    _ApiService_GetContractStorage_Handler,
+		},
+		{
+			MethodName: "GetTxPoolStatus",
+			Handler:    _ApiService_GetTxPoolStatus_Handler,
+		},
+		{
+			MethodName: "GetPendingTransactions",
+			Handler:    _ApiService_GetPendingTransactions_Handler,
+		},
+		{
+			MethodName: "GetPooledTransaction",
+			Handler:    _ApiService_GetPooledTransaction_Handler,
+		},
+		{
+			MethodName: "GetNextNonce",
+			Handler:    _ApiService_GetNextNonce_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2011,126 +3087,177 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("api_rpc.proto", fileDescriptorApiRpc) }
 
 var fileDescriptorApiRpc = []byte{
-	// 1931 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x6e, 0x1b, 0xc9,
-	0x11, 0x06, 0x29, 0x59, 0x12, 0x8b, 0xfa, 0x6d, 0x5b, 0x12, 0x45, 0x4b, 0xb2, 0xdc, 0xde, 0x60,
-	0xb5, 0x0a, 0x2c, 0xae, 0xe9, 0x64, 0x1d, 0x38, 0x27, 0x5b, 0x32, 0x64, 0x01, 0x8e, 0x21, 0x8c,
-	0x9c, 0xec, 0x61, 0xb1, 0x20, 0x9a, 0x33, 0xed, 0x61, 0xc3, 0xe4, 0xcc, 0xec, 0x74, 0x53, 0x5a,
-	0xe9, 0x90, 0x00, 0xb9, 0xe5, 0x9c, 0x37, 0xc8, 0x6d, 0x1f, 0x22, 0x87, 0x3c, 0x43, 0x9e, 0x20,
-	0x40, 0x6e, 0x79, 0x89, 0xa0, 0x6b, 0xba, 0xe7, 0x9f, 0xab, 0x5d, 0xec, 0x8d, 0x55, 0x5d, 0x5d,
-	0x55, 0x5d, 0x3f, 0x5f, 0x15, 0x07, 0x56, 0x58, 0x24, 0x06, 0x71, 0xe4, 0x1e, 0x47, 0x71, 0xa8,
-	0x42, 0x72, 0x2f, 0x8e, 0xdc, 0x68, 0xd8, 0xdd, 0xf5, 0xc3, 0xd0, 0x1f, 0xf3, 0x1e, 0x8b, 0x44,
-	0x8f, 0x05, 0x41, 0xa8, 0x98, 0x12, 0x61, 0x20, 0x13, 0xa1, 0xee, 0x73, 0x5f, 0xa8, 0xd1, 0x74,
-	0x78, 0xec, 0x86, 0x93, 0x5e, 0xc0, 0x87, 0xd3, 0x31, 0x93, 0x22, 0xec, 0xf9, 0xe1, 0x53, 0x43,
-	0xf4, 0xdc, 0x30, 0xe6, 0xbd, 0x68, 0xd8, 0x1b, 0x8e, 0x43, 0xf7, 0x53, 0x72, 0x89, 0x1e, 0xc2,
-	0xfa, 0xe5, 0x74, 0x28, 0xdd, 0x58, 0x0c, 0xb9, 0xc3, 0xbf, 0x9b, 0x72, 0xa9, 0xc8, 0x03, 0xb8,
-	0xa7, 0xc2, 0x48, 0xb8, 0x9d, 0xc6, 0xc1, 0xdc, 0x61, 0xcb, 0x49, 0x08, 0xfa, 0x02, 0xb6, 0x4e,
-	0x46, 0x2c, 0xf0, 0xf9, 0x7b, 0xae, 0xae, 0xc3, 0xf8, 0xd3, 0xf9, 0xa9, 0x95, 0xdf, 0x03, 0x08,
-	0x12, 0xde, 0x40, 0x78, 0x9d, 0xc6, 0x41, 0xe3, 0x70, 0xc5, 0x69, 0x19, 0xce, 0xb9, 0x47, 0x9f,
-	0xc1, 0x76, 0xe5, 0xa2, 0x8c, 0xc2, 0x40, 0x72, 0xb2, 0x05, 0x0b, 0x31, 0x97, 0xd3, 0xb1, 0xc2,
-	0x5b, 0x4b, 0x8e, 0xa1, 0xe8, 0x6b, 0xd8, 0xc8, 0x79, 0x65, 0x84, 0x77, 0x60, 0x69, 0x22, 0xfd,
-	0x81, 0xba, 0x89, 0x38, 0x8a, 0xb7, 0x9c, 0xc5, 0x89, 0xf4, 0x3f, 0xdc, 0x44, 0x9c, 0x10, 0x98,
-	0xf7, 0x98, 0x62, 0x9d, 0x26, 0xb2, 0xf1, 0x37, 0x25, 0xb0, 0xfe, 0x3e, 0x0c, 0x2e, 0x58, 0xcc,
-	0x26, 0xd2, 0x78, 0x4a, 0x7f, 0x98, 0xd3, 0x4c, 0x8f, 0x9f, 0x07, 0x1f, 0xc3, 0x54, 0xef, 0x2a,
-	0x34, 0x8d, 0xdb, 0x2d, 0xa7, 0x29, 0x3c, 0x6d, 0xc7, 0x1d, 0x31, 0x11, 0xe8, 0xc7, 0x34, 0xf1,
-	0x31, 0x8b, 0x48, 0x9f, 0x7b, 0xa4, 0x03, 0x8b, 0x57, 0x3c, 0x96, 0x22, 0x0c, 0x3a, 0x73, 0xc9,
-	0x89, 0x21, 0x75, 0x0c, 0x22, 0xce, 0xe3, 0x81, 0x1b, 0x4e, 0x03, 0xd5, 0x99, 0x4f, 0x62, 0xa0,
-	0x39, 0x27, 0x9a, 0x41, 0x28, 0x2c, 0xcb, 0x9b, 0xc0, 0x1d, 0xc5, 0x61, 0x20, 0x6e, 0xb9, 0xd7,
-	0xb9, 0x87, 0xcf, 0x2d, 0xf0, 0xc8, 0x23, 0x68, 0x0f, 0xa7, 0xee, 0x27, 0xae, 0x06, 0x52, 0xdc,
-	0xf2, 0xce, 0xc2, 0x41, 0xe3, 0xf0, 0x9e, 0x03, 0x09, 0xeb, 0x52, 0xdc, 0x72, 0x72, 0x08, 0xeb,
-	0x31, 0x1f, 0xb3, 0x9b, 0x81, 0xcb, 0xdc, 0x11, 0x4f, 0xa4, 0x16, 0x51, 0x6a, 0x15, 0xf9, 0x27,
-	0x9a, 0x8d, 0x92, 0x47, 0xb0, 0x21, 0x55, 0xcc, 0xd9, 0x64, 0x20, 0x55, 0x18, 0x1b, 0xd1, 0x25,
-	0x14, 0x5d, 0x4b, 0x0e, 0x2e, 0x35, 0x1f, 0x65, 0x5f, 0x40, 0xa7, 0x20, 0xcb, 0xbf, 0x57, 0x3c,
-	0xf0, 0x92, 0x2b, 0x2d, 0xbc, 0xb2, 0x99, 0xbb, 0xf2, 0x06, 0x4f, 0xf1, 0xe2, 0x17, 0xb0, 0x8e,
-	0x35, 0xe4, 0x86, 0xe3, 0x81, 0x8d, 0x0a, 0x60, 0x14, 0xd7, 0x2c, 0xff, 0x4f, 0x26, 0x3a, 0x7d,
-	0x68, 0xc7, 0xe1, 0x54, 0xf1, 0x81, 0x62, 0xc3, 0x31, 0xef, 0xb4, 0x0f, 0xe6, 0x0e, 0xdb, 0xfd,
-	0x8d, 0x63, 0xac, 0xea, 0x63, 0x47, 0x9f, 0x7c, 0xd0, 0x07, 0x0e, 0xc4, 0xe9, 0x6f, 0xfa, 0x67,
-	0xe8, 0x5e, 0xea, 0x02, 0x97, 0x4a, 0xb8, 0xb2, 0x92, 0xb4, 0x2d, 0x58, 0x40, 0xde, 0xa9, 0x49,
-	0x9c, 0xa1, 0x34, 0xff, 0x2d, 0x17, 0xfe, 0x48, 0x61, 0xea, 0xe6, 0x1d, 0x43, 0xe9, 0x0a, 0x79,
-	0xcb, 0xe4, 0x08, 0xd3, 0xd6, 0x72, 0xf0, 0x37, 0xd9, 0x85, 0xd6, 0x85, 0xcd, 0x90, 0x4d, 0x59,
-	0xca, 0xa0, 0x5f, 0x01, 0x64, 0x9e, 0x55, 0x8a, 0xa4, 0x03, 0x8b, 0xcc, 0xf3, 0x62, 0x2e, 0x65,
-	0xa7, 0x89, 0x5d, 0x62, 0x49, 0xfa, 0xbf, 0x06, 0xdc, 0x3f, 0xe3, 0xea, 0x3d, 0x1f, 0x6a, 0xf7,
-	0x0b, 0xe5, 0x9b, 0x96, 0x55, 0xa3, 0x58, 0x56, 0x04, 0xe6, 0x15, 0x13, 0x63, 0x5b, 0xbe, 0xfa,
-	0x37, 0xe9, 0xc2, 0x92, 0x1b, 0x8a, 0x60, 0xc8, 0x24, 0x37, 0x4e, 0xa7, 0xf4, 0x5d, 0xc5, 0xf6,
-	0x10, 0x5a, 0x42, 0x0e, 0x26, 0x22, 0x10, 0x81, 0x6f, 0x2a, 0x6d, 0x49, 0xc8, 0x3f, 0x20, 0x5d,
-	0x9b, 0xb5, 0x85, 0xfa, 0xac, 0x95, 0x8b, 0x76, 0xb1, 0x5a, 0xb4, 0xf4, 0x4b, 0x58, 0x7f, 0xe5,
-	0xa2, 0x1f, 0x32, 0x7d, 0xe9, 0x2e, 0xb4, 0x4c, 0x30, 0xb8, 0x34, 0x18, 0x92, 0x31, 0xe8, 0x5b,
-	0xd8, 0x3a, 0xe3, 0xca, 0x5c, 0x32, 0x21, 0x4a, 0x70, 0x24, 0x17, 0x53, 0xd3, 0xdf, 0x86, 0xd4,
-	0x88, 0x84, 0xa0, 0x65, 0x22, 0x94, 0x10, 0xf4, 0x1c, 0xb6, 0x2b, 0x9a, 0x8c, 0x0b, 0x1d, 0x58,
-	0x1c, 0xb2, 0x31, 0x0b, 0xdc, 0x14, 0x2a, 0x0c, 0xa9, 0x55, 0x05, 0xa1, 0xe6, 0x1b, 0x55, 0x48,
-	0xd0, 0xdf, 0x00, 0x39, 0xe3, 0xea, 0xf4, 0x26, 0x60, 0x52, 0xdd, 0xa4, 0x5a, 0xf6, 0x01, 0x3c,
-	0x3e, 0xe6, 0x3e, 0x53, 0x3c, 0x7d, 0x49, 0x8e, 0x43, 0xff, 0xd9, 0x04, 0xf2, 0x21, 0x66, 0x81,
-	0x64, 0xae, 0x06, 0x62, 0xfb, 0x0e, 0x02, 0xf3, 0x1f, 0xe3, 0x70, 0x62, 0x2c, 0xe3, 0x6f, 0x5d,
-	0x3f, 0x2a, 0x34, 0x36, 0x9b, 0x2a, 0xd4, 0x6e, 0x5c, 0xb1, 0xf1, 0xd4, 0xe6, 0x36, 0x21, 0x32,
-	0xe7, 0xe6, 0xb1, 0x78, 0x13, 0x42, 0xe7, 0xd3, 0x67, 0x72, 0x10, 0xc5, 0xc2, 0xe5, 0x98, 0xcf,
-	0x96, 0xb3, 0xe4, 0x33, 0x79, 0xa1, 0x69, 0x7b, 0x38, 0x16, 0x13, 0xa1, 0x4c, 0x22, 0xf5, 0xe1,
-	0x3b, 0x4d, 0x93, 0xbe, 0x2e, 0xa2, 0x40, 0xc5, 0xcc, 0x55, 0x98, 0xbd, 0x76, 0x7f, 0xcb, 0x34,
-	0xdd, 0x89, 0x61, 0x1b, 0x9f, 0x9d, 0x54, 0x8e, 0xfc, 0x16, 0x5a, 0x2e, 0x0b, 0x3c, 0xe1, 0x31,
-	0x95, 0x60, 0x46, 0xbb, 0xbf, 0x6d, 0x2f, 0x59, 0xbe, 0xbd, 0x95, 0x49, 0x6a, 0x53, 0x36, 0x32,
-	0x08, 0x1b, 0x99, 0xa9, 0x53, 0xc3, 0x4e, 0x4d, 0x59, 0x39, 0x7a, 0x0b, 0x6b, 0x25, 0x3f, 0x74,
-	0xff, 0xca, 0x70, 0x1a, 0xa7, 0x79, 0x33, 0x94, 0x06, 0xc7, 0xe4, 0x57, 0x82, 0xff, 0x49, 0x20,
-	0x21, 0x61, 0xe1, 0x08, 0xe8, 0xc2, 0xd2, 0xc7, 0x69, 0x80, 0x79, 0xb0, 0xfd, 0x62, 0x69, 0x9d,
-	0x10, 0x16, 0xfb, 0x12, 0xa3, 0xda, 0x72, 0xf0, 0x37, 0x3d, 0x82, 0xf5, 0xf2, 0x73, 0xb4, 0xf1,
-	0x24, 0x93, 0xd6, 0x78, 0x42, 0xd1, 0x33, 0x58, 0x2b, 0x3d, 0x62, 0x96, 0xa8, 0xae, 0xfd, 0xb4,
-	0x40, 0x8c, 0x97, 0x19, 0x83, 0xf6, 0x60, 0xe7, 0x92, 0x07, 0x9e, 0xc3, 0xae, 0xeb, 0xcb, 0x06,
-	0x87, 0x98, 0x56, 0xb8, 0x6c, 0x86, 0x98, 0x82, 0x6d, 0x7d, 0xa1, 0x20, 0x9d, 0x21, 0xa0, 0xfa,
-	0x7e, 0xa4, 0x31, 0xcd, 0x78, 0x90, 0x50, 0xba, 0xc1, 0x6d, 0x2e, 0x07, 0x19, 0x44, 0x61, 0x83,
-	0x5b, 0xfe, 0x2b, 0xd3, 0x56, 0xd9, 0xf8, 0x9d, 0x2b, 0x8c, 0xdf, 0x5f, 0xc3, 0xe6, 0x19, 0x57,
-	0xaf, 0x75, 0x93, 0xbd, 0xbe, 0xd1, 0x50, 0x99, 0x73, 0x31, 0x67, 0x11, 0x7f, 0xd3, 0x67, 0xf0,
-	0xf0, 0x8c, 0xab, 0x9c, 0x87, 0x77, 0x5f, 0x39, 0x84, 0x75, 0x54, 0x7e, 0x3a, 0x9d, 0x44, 0xb9,
-	0xa5, 0x23, 0x81, 0xb3, 0x06, 0xce, 0x9c, 0x84, 0xa0, 0x9f, 0xc3, 0x46, 0x4e, 0xd2, 0xbc, 0x3c,
-	0x1f, 0x28, 0x3b, 0xed, 0xff, 0xd3, 0x80, 0x6e, 0x21, 0x4a, 0x2e, 0x17, 0x91, 0xca, 0x5f, 0x29,
-	0x7b, 0x91, 0xb6, 0x69, 0xb3, 0xd2, 0xa6, 0x73, 0xf9, 0x36, 0xad, 0x69, 0xc8, 0x5d, 0x68, 0x29,
-	0x31, 0xe1, 0x52, 0xb1, 0x49, 0x84, 0x0d, 0x39, 0xe7, 0x64, 0x8c, 0xd4, 0xbd, 0x85, 0xcc, 0x3d,
-	0x8d, 0x47, 0x06, 0xec, 0xb1, 0x0f, 0x73, 0xd8, 0x5f, 0x97, 0xae, 0xa5, 0xda, 0x74, 0xd1, 0xe7,
-	0xb0, 0xf1, 0x9e, 0x5f, 0x1b, 0xbc, 0xb3, 0x71, 0xdb, 0x07, 0x88, 0x98, 0x94, 0xd1, 0x28, 0xd6,
-	0x93, 0x22, 0x79, 0x5f, 0x8e, 0x43, 0x8f, 0x81, 0xe4, 0x2f, 0x65, 0xf8, 0x58, 0x0f, 0xb5, 0xf4,
-	0x02, 0x1e, 0xfc, 0x31, 0xd0, 0x21, 0x2f, 0xd9, 0x99, 0x0d, 0xce, 0x45, 0x0f, 0x9a, 0x15, 0x0f,
-	0x7a, 0xb0, 0x59, 0xd2, 0x78, 0xc7, 0xf6, 0x77, 0x0c, 0xe4, 0xdd, 0xcf, 0x70, 0x80, 0x3e, 0x85,
-	0xfb, 0xef, 0x7e, 0x86, 0xfa, 0xa7, 0xb0, 0x7d, 0x29, 0xfc, 0xa0, 0xae, 0xa7, 0xea, 0x5a, 0xf0,
-	0x2f, 0x70, 0x50, 0x6a, 0xc1, 0x8b, 0xf4, 0x6d, 0xd6, 0xb7, 0xdf, 0x43, 0x5b, 0x65, 0xe7, 0x78,
-	0xbd, 0xdd, 0xdf, 0x31, 0xf8, 0x57, 0x6d, 0x75, 0x27, 0x2f, 0x7d, 0x67, 0xfc, 0x5e, 0xc0, 0xe3,
-	0x1f, 0x71, 0x60, 0x76, 0x81, 0xd3, 0x1e, 0xac, 0x9f, 0x99, 0x31, 0x91, 0xca, 0x15, 0x66, 0x49,
-	0xa3, 0x38, 0x4b, 0xe8, 0xef, 0xe0, 0xfe, 0x1b, 0xa9, 0xc4, 0x84, 0x29, 0x7e, 0xc6, 0xb2, 0x79,
-	0xfe, 0x18, 0x96, 0xb9, 0x61, 0x0f, 0x7c, 0x66, 0xc3, 0xdf, 0xe6, 0x99, 0x28, 0xfd, 0x0a, 0x56,
-	0xdf, 0x5c, 0xf1, 0xfc, 0x12, 0xf0, 0x19, 0x2c, 0x70, 0xe4, 0xe0, 0xdc, 0x6c, 0xf7, 0x97, 0x4d,
-	0x34, 0x50, 0xcc, 0x31, 0x67, 0xf4, 0x19, 0xdc, 0x43, 0x46, 0xfe, 0x3f, 0x47, 0x23, 0xfd, 0xcf,
-	0x51, 0xb7, 0xd7, 0xf7, 0xff, 0xd5, 0x06, 0x78, 0x15, 0x89, 0x4b, 0x1e, 0x5f, 0xe9, 0xf9, 0xf7,
-	0x2d, 0xb4, 0x73, 0xdb, 0x16, 0xb1, 0xa3, 0xaa, 0xbc, 0xfa, 0x77, 0xbb, 0xe6, 0xa0, 0x66, 0x35,
-	0xa3, 0x3b, 0x7f, 0xfd, 0xf7, 0x7f, 0xff, 0xde, 0xbc, 0x4f, 0x36, 0x7a, 0x57, 0xcf, 0x7a, 0x53,
-	0xc9, 0x63, 0xfd, 0xff, 0x49, 0xa2, 0xbe, 0xaf, 0x61, 0xc9, 0xee, 0x9e, 0xb3, 0x75, 0x67, 0x07,
-	0xc5, 0x2d, 0xb5, 0x4e, 0x71, 0xe8, 0x71, 0xa1, 0x95, 0x7d, 0x0b, 0xad, 0x14, 0xd9, 0x52, 0xcd,
-	0x65, 0x54, 0xec, 0x76, 0xaa, 0x07, 0x46, 0xf5, 0x1e, 0xaa, 0xde, 0xa6, 0x24, 0x55, 0x8d, 0x4b,
-	0x91, 0x37, 0x9d, 0x44, 0x2f, 0x1b, 0x47, 0xda, 0x6f, 0xbb, 0x97, 0xdd, 0xed, 0x77, 0x79, 0x83,
-	0xab, 0xf1, 0x9b, 0x59, 0x65, 0x31, 0xac, 0x95, 0x96, 0x2e, 0xb2, 0x97, 0x85, 0xb6, 0x66, 0xad,
-	0xeb, 0xee, 0xcf, 0x3a, 0x36, 0xc6, 0x0e, 0xd0, 0x58, 0x97, 0x6e, 0x56, 0x8c, 0x69, 0x31, 0xfd,
-	0x98, 0x09, 0xac, 0x95, 0x3a, 0x80, 0xcc, 0x6e, 0xae, 0xd4, 0xde, 0x8c, 0xc1, 0x49, 0x1f, 0xa1,
-	0xbd, 0x1d, 0xfa, 0x20, 0xb5, 0x97, 0xeb, 0x46, 0x6d, 0xee, 0x1b, 0x98, 0x3f, 0x61, 0xe3, 0xf1,
-	0x2f, 0xb1, 0xd1, 0x41, 0x1b, 0x84, 0xae, 0xa4, 0x36, 0x5c, 0x36, 0x1e, 0x6b, 0xe5, 0xb7, 0x40,
-	0xaa, 0x2b, 0x00, 0x39, 0xc8, 0xe9, 0xab, 0xdd, 0x0e, 0xee, 0xb4, 0x48, 0xd1, 0xe2, 0x2e, 0xdd,
-	0x4e, 0x2d, 0xc6, 0xec, 0xba, 0xf4, 0x30, 0x06, 0xab, 0xc5, 0xb9, 0x4e, 0x76, 0xb3, 0xdc, 0x54,
-	0xc7, 0x7d, 0x77, 0xe5, 0xd8, 0x0d, 0x63, 0x6e, 0xcb, 0xaf, 0xc6, 0x84, 0x5f, 0xb8, 0xa6, 0x4d,
-	0xfc, 0xad, 0x81, 0xbb, 0x43, 0x75, 0x14, 0x13, 0x9a, 0x99, 0x9a, 0xb5, 0x2c, 0x74, 0x1f, 0xd7,
-	0x45, 0xbc, 0x30, 0xc9, 0xe9, 0x17, 0xe8, 0xc4, 0x13, 0xba, 0x9f, 0x77, 0xa2, 0x2a, 0xaf, 0x7d,
-	0x19, 0x40, 0x2b, 0xfd, 0x8a, 0x90, 0x36, 0x41, 0xf9, 0x6b, 0x47, 0xda, 0x62, 0x95, 0x0f, 0x0e,
-	0x35, 0x2d, 0x26, 0xad, 0xcc, 0xcb, 0xc6, 0xd1, 0x97, 0x0d, 0x83, 0x3d, 0x16, 0x63, 0xef, 0xee,
-	0xb3, 0x32, 0x1a, 0xd3, 0x5d, 0xb4, 0xb0, 0x45, 0x1e, 0xe4, 0x1f, 0x93, 0xea, 0xe3, 0xd0, 0xce,
-	0xc1, 0xf1, 0x8f, 0x95, 0xa3, 0x05, 0xb7, 0x1a, 0xf4, 0xae, 0x29, 0xf7, 0x1c, 0x70, 0xeb, 0x30,
-	0x7d, 0x87, 0x1d, 0x9d, 0xc0, 0xb7, 0x29, 0x8b, 0x9f, 0x92, 0xab, 0xcd, 0x3c, 0xa0, 0x67, 0xe6,
-	0x9e, 0xa0, 0xb9, 0x3d, 0xda, 0xc9, 0x3f, 0x29, 0xaf, 0xfc, 0x65, 0xe3, 0xa8, 0xff, 0xc3, 0x22,
-	0x2c, 0xbf, 0xf2, 0x26, 0x22, 0xb0, 0x28, 0xee, 0x02, 0x64, 0x5b, 0x0a, 0xb1, 0x29, 0xa9, 0x6c,
-	0x3b, 0xdd, 0x9d, 0x9a, 0x93, 0x3a, 0x18, 0x61, 0x5a, 0xb9, 0xc5, 0x91, 0x5e, 0xc0, 0xaf, 0xf5,
-	0x43, 0x43, 0x58, 0x29, 0x2c, 0x22, 0xe4, 0xa1, 0xd1, 0x56, 0xb7, 0xf0, 0x74, 0x77, 0xeb, 0x0f,
-	0xeb, 0x9e, 0x59, 0xb4, 0x36, 0xc5, 0x0b, 0xda, 0xa0, 0x0f, 0xed, 0xdc, 0x62, 0x92, 0x26, 0xb0,
-	0xba, 0xdc, 0xa4, 0x09, 0xac, 0xd9, 0x63, 0xe8, 0x63, 0x34, 0xf5, 0x90, 0x6e, 0x55, 0x4d, 0x65,
-	0x86, 0xd6, 0x4a, 0x2b, 0xcd, 0x4f, 0x02, 0xaf, 0xfa, 0x2d, 0xc8, 0xa2, 0x3f, 0x5d, 0xcd, 0x0c,
-	0x4a, 0xe1, 0x23, 0x82, 0xfc, 0xa3, 0x01, 0x7b, 0x25, 0x04, 0xfa, 0x5a, 0xa8, 0x51, 0xb6, 0x90,
-	0x90, 0xcf, 0xeb, 0x71, 0xaa, 0xb2, 0x33, 0x75, 0x0f, 0xef, 0x16, 0x34, 0xfe, 0x1c, 0xa3, 0x3f,
-	0x87, 0xf4, 0x49, 0xe6, 0x8f, 0x9a, 0x65, 0x5f, 0x3b, 0x79, 0x0d, 0xa4, 0xfa, 0xe5, 0x68, 0x76,
-	0x77, 0x5a, 0xd0, 0x99, 0xfd, 0xb5, 0x89, 0xfe, 0x0a, 0x3d, 0x78, 0x44, 0xf6, 0x72, 0x11, 0x49,
-	0xa5, 0x71, 0xa6, 0xa3, 0x89, 0x6f, 0x00, 0xb2, 0xaf, 0x08, 0xb3, 0x0d, 0xee, 0x64, 0xdd, 0x55,
-	0xfa, 0xe2, 0x50, 0x1c, 0xbc, 0x89, 0x21, 0xcf, 0xa8, 0xbb, 0x82, 0xb5, 0xd2, 0x67, 0xd4, 0x74,
-	0xf0, 0xd6, 0x7f, 0x97, 0x4d, 0xf3, 0x3c, 0xe3, 0xeb, 0x2b, 0xfd, 0x0c, 0x8d, 0xed, 0xd3, 0x9d,
-	0xcc, 0x98, 0x5b, 0x14, 0x7d, 0xd9, 0x38, 0x1a, 0x2e, 0xe0, 0x67, 0xa1, 0xe7, 0xff, 0x0f, 0x00,
-	0x00, 0xff, 0xff, 0x7c, 0x04, 0xa8, 0x24, 0x93, 0x16, 0x00, 0x00,
+	// 2742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x6e, 0x1c, 0xc7,
+	0x11, 0xc6, 0xec, 0x52, 0xdc, 0xdd, 0xda, 0xe5, 0x8f, 0x86, 0x14, 0x39, 0x1c, 0x91, 0x14, 0xd9,
+	0xb2, 0x63, 0x5a, 0x86, 0xb8, 0x16, 0x9d, 0xd8, 0x80, 0x72, 0x92, 0x25, 0x81, 0x16, 0xe2, 0x08,
+	0xc4, 0x50, 0x8e, 0x0f, 0x81, 0xb1, 0xe8, 0x9d, 0x6d, 0xee, 0x0e, 0x34, 0x3b, 0x33, 0x9e, 0xee,
+	0xe5, 0x8f, 0x02, 0xd8, 0x89, 0x93, 0x4b, 0x80, 0xdc, 0x72, 0xcd, 0xc9, 0x37, 0x3f, 0x44, 0x9e,
+	0x21, 0x08, 0xf2, 0x0a, 0xb9, 0xe7, 0x15, 0x82, 0xfe, 0x9b, 0xe9, 0xf9, 0x59, 0x91, 0x0e, 0x90,
+	0x1c, 0x72, 0x9b, 0xaa, 0xae, 0xee, 0xaa, 0xae, 0xaa, 0xae, 0xfa, 0xba, 0x77, 0x61, 0x09, 0x27,
+	0xc1, 0x20, 0x4d, 0xfc, 0xc3, 0x24, 0x8d, 0x59, 0x6c, 0xdf, 0x4a, 0x13, 0x3f, 0x19, 0xba, 0xdb,
+	0xe3, 0x38, 0x1e, 0x87, 0xa4, 0x8f, 0x93, 0xa0, 0x8f, 0xa3, 0x28, 0x66, 0x98, 0x05, 0x71, 0x44,
+	0xa5, 0x90, 0xfb, 0xd1, 0x38, 0x60, 0x93, 0xd9, 0xf0, 0xd0, 0x8f, 0xa7, 0xfd, 0x88, 0x0c, 0x67,
+	0x21, 0xa6, 0x41, 0xdc, 0x1f, 0xc7, 0x0f, 0x15, 0xd1, 0xf7, 0xe3, 0x94, 0xf4, 0x93, 0x61, 0x7f,
+	0x18, 0xc6, 0xfe, 0x6b, 0x39, 0x09, 0x1d, 0xc0, 0xea, 0xe9, 0x6c, 0x48, 0xfd, 0x34, 0x18, 0x12,
+	0x8f, 0x7c, 0x3d, 0x23, 0x94, 0xd9, 0xeb, 0x70, 0x8b, 0xc5, 0x49, 0xe0, 0x3b, 0xd6, 0x5e, 0xf3,
+	0xa0, 0xe3, 0x49, 0x02, 0x7d, 0x02, 0x1b, 0x4f, 0x27, 0x38, 0x1a, 0x93, 0x97, 0x84, 0x5d, 0xc4,
+	0xe9, 0xeb, 0x17, 0xcf, 0xb4, 0xfc, 0x0e, 0x40, 0x24, 0x79, 0x83, 0x60, 0xe4, 0x58, 0x7b, 0xd6,
+	0xc1, 0x92, 0xd7, 0x51, 0x9c, 0x17, 0x23, 0xf4, 0x08, 0x36, 0x2b, 0x13, 0x69, 0x12, 0x47, 0x94,
+	0xd8, 0x1b, 0xb0, 0x98, 0x12, 0x3a, 0x0b, 0x99, 0x98, 0xd5, 0xf6, 0x14, 0x85, 0x3e, 0x85, 0xdb,
+	0x86, 0x55, 0x4a, 0x78, 0x0b, 0xda, 0x53, 0x3a, 0x1e, 0xb0, 0xab, 0x84, 0x08, 0xf1, 0x8e, 0xd7,
+	0x9a, 0xd2, 0xf1, 0xab, 0xab, 0x84, 0xd8, 0x36, 0x2c, 0x8c, 0x30, 0xc3, 0x4e, 0x43, 0xb0, 0xc5,
+	0x37, 0xb2, 0x61, 0xf5, 0x65, 0x1c, 0x9d, 0xe0, 0x14, 0x4f, 0xa9, 0xb2, 0x14, 0xfd, 0xd0, 0xe4,
+	0xcc, 0x11, 0x79, 0x11, 0x9d, 0xc5, 0xd9, 0xba, 0xcb, 0xd0, 0x50, 0x66, 0x77, 0xbc, 0x46, 0x30,
+	0xe2, 0x7a, 0xfc, 0x09, 0x0e, 0x22, 0xbe, 0x99, 0x86, 0xd8, 0x4c, 0x4b, 0xd0, 0x2f, 0x46, 0xb6,
+	0x03, 0xad, 0x73, 0x92, 0xd2, 0x20, 0x8e, 0x9c, 0xa6, 0x1c, 0x51, 0x24, 0xf7, 0x41, 0x42, 0x48,
+	0x3a, 0xf0, 0xe3, 0x59, 0xc4, 0x9c, 0x05, 0xe9, 0x03, 0xce, 0x79, 0xca, 0x19, 0x36, 0x82, 0x1e,
+	0xbd, 0x8a, 0xfc, 0x49, 0x1a, 0x47, 0xc1, 0x1b, 0x32, 0x72, 0x6e, 0x89, 0xed, 0x16, 0x78, 0xf6,
+	0x3d, 0xe8, 0x0e, 0x67, 0xfe, 0x6b, 0xc2, 0x06, 0x34, 0x78, 0x43, 0x9c, 0xc5, 0x3d, 0xeb, 0xe0,
+	0x96, 0x07, 0x92, 0x75, 0x1a, 0xbc, 0x21, 0xf6, 0x01, 0xac, 0xa6, 0x24, 0xc4, 0x57, 0x03, 0x1f,
+	0xfb, 0x13, 0x22, 0xa5, 0x5a, 0x42, 0x6a, 0x59, 0xf0, 0x9f, 0x72, 0xb6, 0x90, 0x7c, 0x00, 0xb7,
+	0x29, 0x4b, 0x09, 0x9e, 0x0e, 0x28, 0x8b, 0x53, 0x25, 0xda, 0x16, 0xa2, 0x2b, 0x72, 0xe0, 0x94,
+	0xf3, 0x85, 0xec, 0x27, 0xe0, 0x14, 0x64, 0xc9, 0x25, 0x23, 0xd1, 0x48, 0x4e, 0xe9, 0x88, 0x29,
+	0x77, 0x8c, 0x29, 0xcf, 0xc5, 0xa8, 0x98, 0xf8, 0x3e, 0xac, 0x8a, 0x1c, 0xf2, 0xe3, 0x70, 0xa0,
+	0xbd, 0x02, 0xc2, 0x8b, 0x2b, 0x9a, 0xff, 0x2b, 0xe5, 0x9d, 0x23, 0xe8, 0xa6, 0xf1, 0x8c, 0x91,
+	0x01, 0xc3, 0xc3, 0x90, 0x38, 0xdd, 0xbd, 0xe6, 0x41, 0xf7, 0xe8, 0xf6, 0xa1, 0xc8, 0xea, 0x43,
+	0x8f, 0x8f, 0xbc, 0xe2, 0x03, 0x1e, 0xa4, 0xd9, 0x37, 0xfa, 0x06, 0xdc, 0x53, 0x9e, 0xe0, 0x94,
+	0x05, 0x3e, 0xad, 0x04, 0x6d, 0x03, 0x16, 0x05, 0xef, 0x99, 0x0a, 0x9c, 0xa2, 0x38, 0xff, 0x33,
+	0x12, 0x8c, 0x27, 0x4c, 0x84, 0x6e, 0xc1, 0x53, 0x14, 0xcf, 0x90, 0xcf, 0x30, 0x9d, 0x88, 0xb0,
+	0x75, 0x3c, 0xf1, 0x6d, 0x6f, 0x43, 0xe7, 0x44, 0x47, 0x48, 0x87, 0x2c, 0x63, 0xa0, 0x8f, 0x01,
+	0x72, 0xcb, 0x2a, 0x49, 0xe2, 0x40, 0x0b, 0x8f, 0x46, 0x29, 0xa1, 0xd4, 0x69, 0x88, 0x53, 0xa2,
+	0x49, 0xf4, 0x97, 0x06, 0xac, 0x1d, 0x13, 0xf6, 0x92, 0x0c, 0xb9, 0xf9, 0x85, 0xf4, 0xcd, 0xd2,
+	0xca, 0x2a, 0xa6, 0x95, 0x0d, 0x0b, 0x0c, 0x07, 0xa1, 0x4e, 0x5f, 0xfe, 0x6d, 0xbb, 0xd0, 0xf6,
+	0xe3, 0x20, 0x1a, 0x62, 0x4a, 0x94, 0xd1, 0x19, 0x7d, 0x5d, 0xb2, 0xdd, 0x85, 0x4e, 0x40, 0x07,
+	0xd3, 0x20, 0x0a, 0xa2, 0xb1, 0xca, 0xb4, 0x76, 0x40, 0x7f, 0x29, 0xe8, 0xda, 0xa8, 0x2d, 0xd6,
+	0x47, 0xad, 0x9c, 0xb4, 0xad, 0x9a, 0xa4, 0x5d, 0x85, 0x66, 0x18, 0x0c, 0x45, 0x6e, 0x75, 0x3c,
+	0xfe, 0xc9, 0x8d, 0x0b, 0x83, 0xe1, 0x60, 0x22, 0xa3, 0xd0, 0x11, 0x51, 0xe8, 0x84, 0xc1, 0x50,
+	0x06, 0x02, 0x7d, 0x08, 0xab, 0x4f, 0x7c, 0x61, 0x38, 0xcd, 0x5c, 0xb3, 0x0d, 0x1d, 0xe5, 0x3d,
+	0x42, 0x55, 0xd1, 0xc9, 0x19, 0xe8, 0x5b, 0xd8, 0x38, 0x26, 0x4c, 0x4d, 0x52, 0x3e, 0x95, 0x85,
+	0xc7, 0x08, 0x82, 0x2a, 0x08, 0x8a, 0xe4, 0x25, 0x4c, 0x54, 0x39, 0xe5, 0x52, 0x49, 0x70, 0xd3,
+	0xc4, 0xc7, 0x60, 0x92, 0xa7, 0x42, 0x47, 0x70, 0x44, 0x3e, 0x6c, 0xc0, 0xa2, 0xb2, 0x7a, 0x41,
+	0xe6, 0x8e, 0xa4, 0xd0, 0x0b, 0xd8, 0xac, 0x18, 0xa0, 0x2c, 0x77, 0xa0, 0x35, 0xc4, 0x21, 0x8e,
+	0xfc, 0xac, 0x24, 0x29, 0x92, 0x5b, 0x10, 0xc5, 0x9c, 0xaf, 0x2c, 0x10, 0x04, 0xfa, 0x29, 0xd8,
+	0xc7, 0x84, 0x3d, 0xbb, 0x8a, 0x30, 0x65, 0x57, 0xd9, 0x2a, 0xbb, 0x00, 0x23, 0x12, 0x92, 0x31,
+	0x66, 0x24, 0x73, 0x80, 0xc1, 0x41, 0x7f, 0x6d, 0x80, 0xfd, 0x2a, 0xc5, 0x11, 0xc5, 0x3e, 0x2f,
+	0xf8, 0x7a, 0xfb, 0x36, 0x2c, 0x9c, 0xa5, 0xf1, 0x54, 0x69, 0x16, 0xdf, 0x3c, 0x4f, 0x59, 0xac,
+	0x74, 0x36, 0x58, 0xcc, 0xcd, 0x38, 0xc7, 0xe1, 0x4c, 0xe7, 0x90, 0x24, 0x72, 0xe3, 0xe4, 0x46,
+	0x25, 0xc1, 0xf3, 0x66, 0x8c, 0xe9, 0x20, 0x49, 0x03, 0x9f, 0x88, 0xbc, 0xe9, 0x78, 0xed, 0x31,
+	0xa6, 0x27, 0x69, 0x90, 0x0f, 0x86, 0xc1, 0x34, 0x60, 0xce, 0x62, 0x36, 0xf8, 0x39, 0xa7, 0xed,
+	0x23, 0x9e, 0xac, 0x11, 0x4b, 0xb1, 0xcf, 0x44, 0x96, 0x74, 0x8f, 0x36, 0xd4, 0xe1, 0x7e, 0xaa,
+	0xd8, 0xca, 0x66, 0x2f, 0x93, 0xb3, 0x7f, 0x06, 0x1d, 0x1f, 0x47, 0xa3, 0x60, 0x84, 0x99, 0xac,
+	0x4d, 0xdd, 0xa3, 0x4d, 0x3d, 0x49, 0xf3, 0xf5, 0xac, 0x5c, 0x92, 0xab, 0xd2, 0x9e, 0x71, 0x3a,
+	0x05, 0x55, 0xcf, 0x14, 0x3b, 0x53, 0xa5, 0xe5, 0xd0, 0x1b, 0x58, 0x29, 0xd9, 0xc1, 0x63, 0x4d,
+	0xe3, 0x59, 0x9a, 0xc5, 0x4d, 0x51, 0xbc, 0x08, 0xcb, 0x2f, 0xd9, 0x67, 0xa4, 0x23, 0x41, 0xb2,
+	0x44, 0xab, 0x71, 0xa1, 0x7d, 0x36, 0x8b, 0x44, 0x1c, 0xf4, 0xb9, 0xd4, 0x34, 0x0f, 0x08, 0x4e,
+	0xc7, 0x54, 0x78, 0xb5, 0xe3, 0x89, 0x6f, 0xf4, 0x00, 0x56, 0xcb, 0xdb, 0xe1, 0xca, 0x65, 0x24,
+	0xb5, 0x72, 0x49, 0xa1, 0x63, 0x58, 0x29, 0x6d, 0x62, 0x9e, 0x28, 0x3f, 0x32, 0x59, 0x82, 0x28,
+	0x2b, 0x73, 0x06, 0xea, 0xc3, 0xd6, 0x29, 0x89, 0x46, 0x1e, 0xbe, 0xa8, 0x4f, 0x1b, 0xd1, 0x2c,
+	0xf9, 0x82, 0x3d, 0xd5, 0x2c, 0x19, 0x6c, 0xf2, 0x09, 0x05, 0xe9, 0xbc, 0xd2, 0xb2, 0x4b, 0x71,
+	0x60, 0x94, 0x05, 0x92, 0xe2, 0x85, 0x44, 0xc7, 0x72, 0x90, 0x97, 0x42, 0x51, 0x48, 0x34, 0xff,
+	0x89, 0x64, 0x1b, 0x6d, 0xbe, 0x59, 0x68, 0xf3, 0xdf, 0x35, 0xa0, 0xfb, 0x14, 0x87, 0xe1, 0xff,
+	0x47, 0x42, 0xe7, 0xe5, 0xa3, 0x6d, 0x96, 0x8f, 0x52, 0xd5, 0xe9, 0x94, 0xaa, 0x0e, 0xfa, 0x93,
+	0x05, 0x3d, 0xe9, 0x84, 0xbc, 0x51, 0x70, 0xc3, 0x66, 0x94, 0xe8, 0x86, 0xd3, 0x1a, 0x63, 0xfa,
+	0x05, 0x25, 0x23, 0xfb, 0x3e, 0x2c, 0x91, 0x4b, 0xe2, 0xf3, 0x4e, 0x4a, 0xd2, 0x34, 0x4e, 0x95,
+	0x5f, 0x7a, 0x8a, 0xf9, 0x9c, 0xf3, 0xec, 0x77, 0x60, 0x91, 0x9c, 0x93, 0x88, 0x51, 0xa7, 0x29,
+	0xfa, 0x6c, 0x4f, 0x59, 0xfe, 0x9c, 0x33, 0x3d, 0x35, 0x66, 0xc4, 0x44, 0x66, 0xab, 0x8e, 0xc9,
+	0x07, 0x70, 0xe7, 0x98, 0xb0, 0x4f, 0xb9, 0x79, 0x9f, 0x5e, 0x71, 0x03, 0x8d, 0xe0, 0x18, 0x59,
+	0x20, 0xbe, 0x39, 0xb4, 0x33, 0x84, 0xc5, 0x76, 0x8d, 0xc4, 0x55, 0xde, 0xb0, 0x0a, 0xc5, 0xf4,
+	0x11, 0xdc, 0x3d, 0x26, 0xcc, 0x48, 0xb4, 0xeb, 0xb5, 0x1c, 0xc0, 0xaa, 0x50, 0xf1, 0x6c, 0x36,
+	0x4d, 0x0c, 0x8c, 0x2a, 0xbb, 0x9f, 0x25, 0x20, 0x8a, 0x24, 0xd0, 0x7b, 0x70, 0xdb, 0x90, 0x54,
+	0xfe, 0x34, 0xf3, 0x5d, 0x83, 0xc3, 0xef, 0x9b, 0xe0, 0x16, 0x92, 0xdd, 0x27, 0x41, 0xc2, 0xcc,
+	0x29, 0x65, 0x2b, 0xb2, 0xe4, 0x6c, 0x54, 0x92, 0xb3, 0x69, 0x26, 0x67, 0x4d, 0x1a, 0x6e, 0x43,
+	0x87, 0x05, 0x53, 0x42, 0x19, 0x9e, 0x26, 0x22, 0x0d, 0x9b, 0x5e, 0xce, 0xc8, 0xcc, 0x5b, 0xcc,
+	0xcd, 0xe3, 0x6d, 0x45, 0x61, 0x03, 0xa7, 0x55, 0x84, 0x0a, 0x75, 0xa7, 0xae, 0x5d, 0x7f, 0xea,
+	0xde, 0x9e, 0x77, 0xf6, 0x3e, 0xf4, 0xd4, 0xb0, 0x0c, 0x13, 0x08, 0x93, 0xbb, 0x52, 0x40, 0xb0,
+	0x44, 0x91, 0x64, 0x98, 0xcd, 0xa8, 0xd3, 0x15, 0x5e, 0x56, 0x54, 0x21, 0x43, 0x7b, 0xd7, 0x64,
+	0xe8, 0x52, 0x4d, 0x86, 0xbe, 0x0b, 0xcb, 0x5a, 0x48, 0xe5, 0xe0, 0xb2, 0x90, 0xd2, 0x53, 0x3d,
+	0x99, 0x8a, 0x1f, 0xc1, 0xed, 0x97, 0xe4, 0x42, 0xf5, 0x5d, 0x1d, 0xf8, 0x5d, 0x80, 0x04, 0x53,
+	0x9a, 0x4c, 0x52, 0x8e, 0x8c, 0x64, 0x80, 0x0c, 0x0e, 0x3a, 0x04, 0xdb, 0x9c, 0x94, 0xf7, 0xe9,
+	0x7a, 0xa4, 0x80, 0x4e, 0x60, 0xfd, 0x8b, 0x88, 0xef, 0xb9, 0xa4, 0x67, 0xee, 0x8c, 0x92, 0x05,
+	0x8d, 0x8a, 0x05, 0x7d, 0xb8, 0x53, 0x5a, 0xf1, 0x9a, 0xdb, 0xce, 0x21, 0xd8, 0x9f, 0xff, 0x08,
+	0x03, 0xd0, 0x43, 0x58, 0xfb, 0xfc, 0x47, 0x2c, 0xff, 0x10, 0x36, 0x4f, 0x83, 0x71, 0x54, 0x57,
+	0xdb, 0xeb, 0x5a, 0xc1, 0xb7, 0xb0, 0x57, 0x6a, 0x05, 0x27, 0xd9, 0xde, 0xb4, 0x6d, 0x3f, 0x87,
+	0x2e, 0xcb, 0xc7, 0xc5, 0xf4, 0xee, 0xd1, 0x96, 0xaa, 0x33, 0xd5, 0x96, 0xe3, 0x99, 0xd2, 0xd7,
+	0xfa, 0xef, 0x13, 0xd8, 0x7f, 0x8b, 0x01, 0xf3, 0x4f, 0x28, 0x9a, 0xc2, 0xea, 0xb1, 0xaa, 0xee,
+	0x99, 0x5c, 0xa1, 0x05, 0x58, 0xa5, 0x16, 0xc0, 0xc1, 0x6b, 0x7c, 0xa1, 0x4c, 0xe0, 0x9f, 0xdc,
+	0x87, 0x53, 0x32, 0x0a, 0xb0, 0xee, 0xed, 0x8a, 0x12, 0xea, 0x82, 0xf1, 0x44, 0x77, 0x76, 0xfe,
+	0x8d, 0x66, 0xb0, 0xf6, 0x9c, 0xb2, 0x60, 0x8a, 0x19, 0x39, 0xc6, 0x39, 0x98, 0xdd, 0x87, 0x1e,
+	0x51, 0xec, 0xc1, 0x18, 0xeb, 0xe0, 0x75, 0x49, 0x2e, 0xca, 0xb5, 0x9c, 0xe1, 0x20, 0x24, 0xf2,
+	0x7e, 0xd9, 0xf6, 0x14, 0x55, 0x3d, 0x3c, 0xcd, 0xea, 0xe1, 0x41, 0x1f, 0xc3, 0xb2, 0xa8, 0xe4,
+	0xb9, 0xc6, 0xbc, 0xe0, 0x5b, 0xf3, 0x0b, 0x3e, 0x7a, 0x04, 0xb7, 0x04, 0xc3, 0xbc, 0xde, 0x5b,
+	0xd9, 0xf5, 0xbe, 0xf6, 0x0a, 0x1d, 0x98, 0xc8, 0xfb, 0x24, 0x8d, 0xe3, 0xb3, 0xeb, 0x4f, 0x47,
+	0xb1, 0xea, 0x34, 0xe6, 0x63, 0xec, 0x66, 0xa1, 0x2d, 0xfc, 0xc1, 0x82, 0xf5, 0xa2, 0x22, 0xb5,
+	0xb9, 0xe2, 0x7a, 0x56, 0x79, 0xbd, 0x1d, 0x00, 0xca, 0xb8, 0xab, 0xd3, 0x38, 0x66, 0x5a, 0x9d,
+	0xe0, 0x78, 0x71, 0x2c, 0xed, 0x94, 0xab, 0x0a, 0x7d, 0x3d, 0x4f, 0x93, 0xdc, 0x0b, 0x09, 0x57,
+	0xe4, 0x2c, 0xec, 0x35, 0x0f, 0x7a, 0x9e, 0x24, 0xd0, 0x6f, 0xc4, 0x8e, 0xf9, 0x4d, 0x17, 0x8f,
+	0xc9, 0x0d, 0x77, 0xbc, 0x0a, 0xcd, 0xd7, 0xe4, 0x4a, 0x67, 0xd1, 0x6b, 0x72, 0xf5, 0x9f, 0xde,
+	0x33, 0xfe, 0x65, 0xc1, 0x7a, 0x51, 0xf5, 0x7f, 0xd9, 0x07, 0xf7, 0x61, 0x49, 0x7d, 0x0e, 0x4c,
+	0x5f, 0xf4, 0xb0, 0x11, 0x08, 0x81, 0x88, 0xa5, 0x51, 0x03, 0xbe, 0xcd, 0x5b, 0x0a, 0x11, 0x4b,
+	0xd6, 0x2f, 0xc8, 0x55, 0x8e, 0xc8, 0x16, 0xc5, 0xea, 0x92, 0xe0, 0x6b, 0xeb, 0x69, 0x72, 0xed,
+	0x96, 0x5c, 0x9b, 0x1a, 0x1b, 0xe4, 0xd8, 0xc7, 0x31, 0xcf, 0xf9, 0x8f, 0xd9, 0xf5, 0x16, 0xb4,
+	0xd9, 0x25, 0x35, 0xf7, 0xdc, 0x62, 0x97, 0x54, 0xec, 0x78, 0xaf, 0x58, 0x9e, 0xe4, 0xae, 0x4d,
+	0xd6, 0x9c, 0xe8, 0x7f, 0x03, 0x5b, 0xc7, 0x84, 0x69, 0x84, 0xa7, 0x42, 0xf1, 0x3f, 0x4c, 0x80,
+	0x23, 0x70, 0xeb, 0xf4, 0x2b, 0x7f, 0x64, 0x7e, 0xb6, 0x0c, 0xe4, 0x8b, 0xfe, 0x66, 0xc1, 0xfa,
+	0xab, 0xcb, 0x93, 0x38, 0x0e, 0x4f, 0x45, 0x73, 0x36, 0xc5, 0x73, 0x84, 0xb4, 0xa4, 0x10, 0x12,
+	0xbf, 0xbe, 0xa8, 0xe8, 0x52, 0xf5, 0xb8, 0x95, 0xd1, 0xbc, 0x04, 0x88, 0x57, 0x1f, 0xf9, 0xb4,
+	0x25, 0xbe, 0xcd, 0x14, 0xa1, 0x61, 0xcc, 0xa8, 0x7a, 0x6d, 0xd0, 0x29, 0x72, 0xca, 0x79, 0x7c,
+	0xd1, 0x30, 0x38, 0x23, 0x1c, 0xd3, 0x88, 0xfc, 0x58, 0xf0, 0x32, 0x9a, 0xbb, 0x2d, 0x21, 0xd1,
+	0x88, 0x3f, 0x45, 0x2c, 0x4a, 0x28, 0xa3, 0x48, 0xee, 0x85, 0xaf, 0x67, 0x64, 0x46, 0x34, 0xc6,
+	0x51, 0x14, 0x6f, 0x87, 0x7c, 0x3b, 0x37, 0x6e, 0x87, 0x57, 0x70, 0xf7, 0x44, 0x2e, 0x69, 0xa4,
+	0x52, 0xee, 0x87, 0x87, 0xb9, 0x01, 0xb2, 0x3c, 0xae, 0x1d, 0xfa, 0x71, 0x4a, 0x4a, 0x8d, 0x2a,
+	0xb3, 0xea, 0x83, 0xcc, 0xaa, 0xc6, 0x7c, 0x69, 0x6d, 0xea, 0xfb, 0x1c, 0xa1, 0x5c, 0xb2, 0x97,
+	0x1c, 0xe6, 0x99, 0x8e, 0x97, 0x20, 0xd0, 0x32, 0x40, 0xe0, 0xd1, 0xdf, 0xd7, 0x00, 0x9e, 0x24,
+	0xc1, 0x29, 0x49, 0xcf, 0x79, 0xeb, 0xf9, 0x0a, 0xba, 0xc6, 0x23, 0x91, 0xad, 0x6f, 0xbe, 0xe5,
+	0x17, 0x4b, 0xd7, 0x55, 0x03, 0x35, 0x2f, 0x4a, 0x68, 0xeb, 0xbb, 0x7f, 0xfc, 0xf3, 0xcf, 0x8d,
+	0x35, 0xfb, 0x76, 0xff, 0xfc, 0x51, 0x7f, 0x46, 0x49, 0xca, 0x9f, 0x7d, 0xc5, 0xb9, 0xb7, 0xbf,
+	0x84, 0xb6, 0x7e, 0x32, 0x9b, 0xbf, 0x76, 0x3e, 0x50, 0x7c, 0x5c, 0xab, 0x5b, 0x38, 0x1e, 0x91,
+	0x80, 0x2f, 0xf6, 0x15, 0x74, 0x32, 0x84, 0x9d, 0xad, 0x5c, 0x46, 0xe7, 0xae, 0x53, 0x1d, 0x50,
+	0x4b, 0xef, 0x88, 0xa5, 0x37, 0x91, 0x9d, 0x2d, 0x2d, 0x8e, 0xc5, 0x68, 0x36, 0x4d, 0x1e, 0x5b,
+	0x0f, 0xb8, 0xdd, 0x4f, 0x74, 0x3a, 0x5e, 0x6b, 0x77, 0xf9, 0x1d, 0xa9, 0xc6, 0xee, 0x2c, 0xb7,
+	0x53, 0x58, 0x29, 0xbd, 0xe1, 0xd8, 0x3b, 0xb9, 0x6b, 0x6b, 0x1e, 0x97, 0xdc, 0xdd, 0x79, 0xc3,
+	0x4a, 0xd9, 0x9e, 0x50, 0xe6, 0xa2, 0x3b, 0x15, 0x65, 0x5c, 0x8c, 0x6f, 0x66, 0x0a, 0x2b, 0x25,
+	0x20, 0x63, 0xcf, 0xc7, 0x48, 0x99, 0xbe, 0x39, 0xf7, 0x70, 0x74, 0x4f, 0xe8, 0xdb, 0x42, 0xeb,
+	0x99, 0x3e, 0xa3, 0xa0, 0x71, 0x75, 0x2f, 0x60, 0x81, 0xdf, 0x23, 0x6d, 0x3b, 0x7b, 0x45, 0xc9,
+	0x6e, 0xd6, 0xee, 0x5a, 0x81, 0xa7, 0x56, 0x74, 0xc4, 0x8a, 0x36, 0x5a, 0xca, 0x56, 0xf4, 0x71,
+	0x18, 0xf2, 0xa5, 0xde, 0x80, 0x5d, 0x7d, 0x3f, 0xb0, 0xf7, 0x0c, 0x0b, 0x6b, 0x9f, 0x16, 0xae,
+	0xdd, 0x03, 0x12, 0x1a, 0xb7, 0xd1, 0x66, 0xa6, 0x31, 0xc5, 0x17, 0xa5, 0x6d, 0x60, 0x58, 0x2e,
+	0x5e, 0x40, 0xed, 0xed, 0x3c, 0x12, 0xd5, 0x7b, 0xa9, 0xbb, 0xa4, 0x0f, 0xa8, 0x18, 0xab, 0x51,
+	0x31, 0x2e, 0x4c, 0xe3, 0x2a, 0xc6, 0xb0, 0x5a, 0xbe, 0xb6, 0xda, 0xbb, 0x55, 0x25, 0xe6, 0x7d,
+	0xb6, 0xac, 0xe6, 0x1d, 0xa1, 0x66, 0x17, 0x6d, 0xd5, 0xa9, 0x11, 0x13, 0xb9, 0xa2, 0x3f, 0x5a,
+	0xe2, 0x36, 0x5d, 0xbd, 0x69, 0xda, 0x28, 0x57, 0x37, 0xef, 0x2e, 0xec, 0xee, 0xd7, 0x25, 0x4b,
+	0xe1, 0xa2, 0x8a, 0xde, 0x17, 0x66, 0xdc, 0x47, 0xbb, 0xa6, 0x19, 0x55, 0x79, 0x6e, 0xcb, 0x00,
+	0x3a, 0xd9, 0x6f, 0x2a, 0xd9, 0xd9, 0x2a, 0xff, 0xf6, 0xe3, 0x3a, 0xd5, 0x81, 0xb9, 0x27, 0x97,
+	0x6a, 0x99, 0xc7, 0xd6, 0x83, 0x0f, 0x2d, 0x55, 0xd2, 0x34, 0x02, 0xbf, 0xfe, 0xf8, 0x96, 0xb1,
+	0x3a, 0xda, 0x16, 0x1a, 0x36, 0xec, 0x75, 0x73, 0x33, 0xd9, 0x7a, 0x04, 0xba, 0x06, 0xdc, 0x7e,
+	0xdb, 0x49, 0xd2, 0x35, 0xb3, 0x06, 0x9d, 0xd7, 0x9c, 0x22, 0x03, 0x98, 0x73, 0x37, 0x7d, 0x2d,
+	0x0a, 0x85, 0x44, 0xd8, 0x2a, 0xff, 0x6e, 0x12, 0xab, 0x3b, 0x26, 0xe6, 0xce, 0xd5, 0xdd, 0x17,
+	0xea, 0x76, 0x90, 0x63, 0x6e, 0xc9, 0x5c, 0x9c, 0xab, 0x2c, 0xd4, 0x26, 0x09, 0xba, 0xaa, 0xb5,
+	0xc9, 0x04, 0xa3, 0xee, 0xdd, 0x62, 0x05, 0x2c, 0xe0, 0xa6, 0x7a, 0x9d, 0xa6, 0x64, 0xae, 0xd3,
+	0x44, 0x9b, 0xa6, 0xce, 0x1a, 0x00, 0x9c, 0xe9, 0xac, 0x43, 0xa8, 0xf5, 0x3a, 0x4d, 0x49, 0xae,
+	0xf3, 0xf7, 0x16, 0xac, 0x15, 0x7d, 0x28, 0x15, 0xdf, 0xc4, 0xbf, 0xf7, 0xaa, 0xe1, 0x2e, 0x5a,
+	0xf0, 0x9e, 0xb0, 0x60, 0x1f, 0x6d, 0xcf, 0x39, 0x09, 0x99, 0x15, 0xbf, 0xb3, 0xc0, 0xae, 0xa2,
+	0xac, 0xac, 0xb8, 0xcd, 0x05, 0x80, 0xee, 0xfe, 0x5b, 0x24, 0x94, 0x11, 0x3f, 0x11, 0x46, 0xec,
+	0xa1, 0xbb, 0xa6, 0x11, 0x25, 0x61, 0x6e, 0xc3, 0x99, 0xf0, 0xbe, 0x09, 0xdb, 0xe6, 0x1f, 0x17,
+	0xed, 0xf7, 0x3a, 0x90, 0xa7, 0x0f, 0xa5, 0x9d, 0x37, 0x21, 0x76, 0x99, 0xc4, 0x71, 0xa8, 0x1e,
+	0x6a, 0x7e, 0x6b, 0x89, 0xfb, 0x4c, 0x0d, 0x3c, 0xca, 0xce, 0x4f, 0x15, 0x6a, 0xb9, 0x3a, 0x1e,
+	0x6f, 0x41, 0x55, 0x35, 0xee, 0x4e, 0xaa, 0xd2, 0x7c, 0xab, 0xe7, 0xb0, 0xce, 0x2d, 0x88, 0xe3,
+	0x90, 0x14, 0x3a, 0xe1, 0x4d, 0x82, 0x5e, 0x87, 0xbd, 0xd0, 0xbb, 0x42, 0xf3, 0x3d, 0xe4, 0xe6,
+	0x9a, 0xcb, 0x8b, 0x73, 0xbd, 0x43, 0xe8, 0x09, 0xcc, 0xa4, 0xd0, 0xd9, 0xdb, 0xf6, 0xab, 0x6b,
+	0x5e, 0x05, 0xca, 0xd5, 0xd4, 0xbc, 0x48, 0xcb, 0x3c, 0xb6, 0x1e, 0x1c, 0xfd, 0xd0, 0x82, 0xde,
+	0x93, 0xd1, 0x34, 0x88, 0x34, 0xaa, 0xf3, 0x01, 0xf2, 0xc7, 0x27, 0x3b, 0x5f, 0xb7, 0xf4, 0x88,
+	0xe5, 0x6e, 0xd5, 0x8c, 0xd4, 0xc1, 0x0a, 0xcc, 0x17, 0xd7, 0xb8, 0xa2, 0x1f, 0x91, 0x0b, 0xbe,
+	0xb3, 0x18, 0x96, 0x0a, 0xef, 0x4b, 0xb6, 0xce, 0x90, 0xba, 0x77, 0x2c, 0x77, 0xbb, 0x7e, 0xb0,
+	0xee, 0xdc, 0x16, 0xb5, 0xcd, 0xc4, 0x04, 0xd9, 0x2e, 0xbb, 0xc6, 0x7b, 0x53, 0xe6, 0xc9, 0xea,
+	0x9b, 0x95, 0xeb, 0xd6, 0x0d, 0x29, 0x55, 0xfb, 0x42, 0xd5, 0x5d, 0xb4, 0x51, 0x55, 0x95, 0x2b,
+	0x5a, 0x29, 0xbd, 0x54, 0xdd, 0x08, 0x30, 0xd5, 0x3f, 0x6e, 0x69, 0x34, 0x88, 0x96, 0x73, 0x85,
+	0x34, 0x18, 0x8b, 0xe4, 0xf8, 0xde, 0x82, 0x9d, 0x12, 0x46, 0xf9, 0x32, 0x60, 0x93, 0xfc, 0x9d,
+	0xc9, 0x7e, 0xaf, 0x1e, 0xc9, 0x54, 0x9e, 0xc2, 0xdc, 0x83, 0xeb, 0x05, 0x95, 0x3d, 0x87, 0xc2,
+	0x9e, 0x03, 0x74, 0x3f, 0xb7, 0x87, 0xcd, 0xd3, 0xcf, 0x8d, 0xbc, 0x00, 0xbb, 0xfa, 0x03, 0xf8,
+	0xfc, 0x3a, 0xb1, 0x9f, 0xd5, 0xe7, 0x79, 0x3f, 0x9a, 0xeb, 0xa3, 0x63, 0xef, 0x18, 0x1e, 0xc9,
+	0xa4, 0xfb, 0x91, 0x12, 0xb7, 0x7f, 0x0d, 0x90, 0xff, 0x48, 0x39, 0x5f, 0xe1, 0x56, 0x7e, 0x82,
+	0x4b, 0x3f, 0x68, 0x16, 0x81, 0xb8, 0x54, 0x34, 0x52, 0xcb, 0x9d, 0xc3, 0x4a, 0xe9, 0xdf, 0x20,
+	0x59, 0xe3, 0xa9, 0xff, 0x7b, 0x89, 0xbb, 0x3b, 0x6f, 0x58, 0x29, 0x2b, 0x40, 0x31, 0xa9, 0xcc,
+	0x2f, 0x8a, 0x3e, 0xb6, 0x1e, 0x0c, 0x17, 0xc5, 0xaf, 0xdb, 0x1f, 0xfd, 0x7b, 0x00, 0x78, 0x1f,
+	0xb8, 0x00, 0x5a, 0x23, 0x00, 0x00,
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/filter"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 // DiskStorage the nodes in trie.
@@ -65,7 +66,41 @@ func (storage *DiskStorage) Del(key []byte) error {
 	return storage.db.Delete(key, nil)
 }
 
+// NewBatch return a Batch writing to Storage
+func (storage *DiskStorage) NewBatch() Batch {
+	return &diskBatch{db: storage.db, batch: new(leveldb.Batch)}
+}
+
+// NewIterator return an Iterator over the entries whose key has the prefix
+func (storage *DiskStorage) NewIterator(prefix []byte) Iterator {
+	return storage.db.NewIterator(util.BytesPrefix(prefix), nil)
+}
+
 // Close levelDB
 func (storage *DiskStorage) Close() error {
 	return storage.db.Close()
 }
+
+type diskBatch struct {
+	db    *leveldb.DB
+	batch *leveldb.Batch
+}
+
+// Put put the key-value entry to Batch
+func (b *diskBatch) Put(key []byte, value []byte) error {
+	b.batch.Put(key, value)
+	return nil
+}
+
+// Del delete the key in Batch
+func (b *diskBatch) Del(key []byte) error {
+	b.batch.Delete(key)
+	return nil
+}
+
+// Write commit all entries in Batch to levelDB atomically
+func (b *diskBatch) Write() error {
+	err := b.db.Write(b.batch, nil)
+	b.batch.Reset()
+	return err
+}
@@ -19,6 +19,7 @@
 package storage
 
 import (
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -37,3 +38,34 @@ func TestNewDiskStorage(t *testing.T) {
 	_, err2 := storage.Get(keys[1])
 	assert.NotNil(t, err2)
 }
+
+func TestDiskStorage_BatchAndIterator(t *testing.T) {
+	storage, err := NewDiskStorage("batch_test.db")
+	assert.Nil(t, err)
+	defer os.RemoveAll("batch_test.db")
+	defer storage.Close()
+
+	batch := storage.NewBatch()
+	assert.Nil(t, batch.Put([]byte("batch_1"), []byte("1")))
+	assert.Nil(t, batch.Put([]byte("batch_2"), []byte("2")))
+	assert.Nil(t, batch.Put([]byte("batch_3"), []byte("3")))
+	assert.Nil(t, batch.Del([]byte("batch_2")))
+	_, err = storage.Get([]byte("batch_1"))
+	assert.Equal(t, ErrKeyNotFound, err)
+	assert.Nil(t, batch.Write())
+
+	value, err := storage.Get([]byte("batch_1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("1"), value)
+	_, err = storage.Get([]byte("batch_2"))
+	assert.Equal(t, ErrKeyNotFound, err)
+
+	iter := storage.NewIterator([]byte("batch_"))
+	var keys []string
+	for iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	assert.Nil(t, iter.Error())
+	iter.Release()
+	assert.Equal(t, []string{"batch_1", "batch_3"}, keys)
+}
@@ -19,6 +19,8 @@
 package storage
 
 import (
+	"bytes"
+	"sort"
 	"sync"
 
 	"github.com/nebulasio/go-nebulas/util/byteutils"
@@ -55,3 +57,119 @@ func (db *MemoryStorage) Del(key []byte) error {
 	db.data.Delete(byteutils.Hex(key))
 	return nil
 }
+
+// NewBatch return a Batch writing to Storage
+func (db *MemoryStorage) NewBatch() Batch {
+	return &memoryBatch{db: db}
+}
+
+// NewIterator return an Iterator over the entries whose key has the prefix
+func (db *MemoryStorage) NewIterator(prefix []byte) Iterator {
+	iter := &memoryIterator{index: -1}
+	db.data.Range(func(k, v interface{}) bool {
+		key, err := byteutils.FromHex(k.(string))
+		if err != nil {
+			iter.err = err
+			return false
+		}
+		if bytes.HasPrefix(key, prefix) {
+			iter.keys = append(iter.keys, key)
+			iter.values = append(iter.values, v.([]byte))
+		}
+		return true
+	})
+	sort.Sort(iter)
+	return iter
+}
+
+type batchEntry struct {
+	key   []byte
+	value []byte
+	del   bool
+}
+
+type memoryBatch struct {
+	db      Storage
+	entries []*batchEntry
+}
+
+// Put put the key-value entry to Batch
+func (b *memoryBatch) Put(key []byte, value []byte) error {
+	b.entries = append(b.entries, &batchEntry{key: key, value: value})
+	return nil
+}
+
+// Del delete the key in Batch
+func (b *memoryBatch) Del(key []byte) error {
+	b.entries = append(b.entries, &batchEntry{key: key, del: true})
+	return nil
+}
+
+// Write commit all entries in Batch to Storage
+func (b *memoryBatch) Write() error {
+	for _, entry := range b.entries {
+		if entry.del {
+			b.db.Del(entry.key)
+		} else {
+			b.db.Put(entry.key, entry.value)
+		}
+	}
+	b.entries = nil
+	return nil
+}
+
+type memoryIterator struct {
+	keys   [][]byte
+	values [][]byte
+	index  int
+	err    error
+}
+
+func (iter *memoryIterator) Len() int {
+	return len(iter.keys)
+}
+
+func (iter *memoryIterator) Less(i, j int) bool {
+	return bytes.Compare(iter.keys[i], iter.keys[j]) < 0
+}
+
+func (iter *memoryIterator) Swap(i, j int) {
+	iter.keys[i], iter.keys[j] = iter.keys[j], iter.keys[i]
+	iter.values[i], iter.values[j] = iter.values[j], iter.values[i]
+}
+
+// Next move to the next entry
+func (iter *memoryIterator) Next() bool {
+	if iter.err != nil || iter.index+1 >= len(iter.keys) {
+		return false
+	}
+	iter.index++
+	return true
+}
+
+// Key return the key of current entry
+func (iter *memoryIterator) Key() []byte {
+	if iter.index < 0 || iter.index >= len(iter.keys) {
+		return nil
+	}
+	return iter.keys[iter.index]
+}
+
+// Value return the value of current entry
+func (iter *memoryIterator) Value() []byte {
+	if iter.index < 0 || iter.index >= len(iter.values) {
+		return nil
+	}
+	return iter.values[iter.index]
+}
+
+// Error return the error occurred in iteration
+func (iter *memoryIterator) Error() error {
+	return iter.err
+}
+
+// Release release the resources of Iterator
+func (iter *memoryIterator) Release() {
+	iter.keys = nil
+	iter.values = nil
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStorage_BatchAndIterator(t *testing.T) {
+	storage, _ := NewMemoryStorage()
+	storage.Put([]byte("other"), []byte("0"))
+
+	batch := storage.NewBatch()
+	assert.Nil(t, batch.Put([]byte("batch_3"), []byte("3")))
+	assert.Nil(t, batch.Put([]byte("batch_1"), []byte("1")))
+	assert.Nil(t, batch.Put([]byte("batch_2"), []byte("2")))
+	assert.Nil(t, batch.Del([]byte("batch_2")))
+	_, err := storage.Get([]byte("batch_1"))
+	assert.Equal(t, ErrKeyNotFound, err)
+	assert.Nil(t, batch.Write())
+
+	value, err := storage.Get([]byte("batch_1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("1"), value)
+	_, err = storage.Get([]byte("batch_2"))
+	assert.Equal(t, ErrKeyNotFound, err)
+
+	iter := storage.NewIterator([]byte("batch_"))
+	var keys, values []string
+	for iter.Next() {
+		keys = append(keys, string(iter.Key()))
+		values = append(values, string(iter.Value()))
+	}
+	assert.Nil(t, iter.Error())
+	iter.Release()
+	assert.Equal(t, []string{"batch_1", "batch_3"}, keys)
+	assert.Equal(t, []string{"1", "3"}, values)
+}
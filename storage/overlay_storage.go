@@ -0,0 +1,134 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// OverlayStorage buffers the writes over a base Storage in memory, the keys not
+// written are read from the base. The base is never written until the buffered
+// entries are flushed into a batch of it.
+type OverlayStorage struct {
+	mu      sync.RWMutex
+	base    Storage
+	entries map[string]*batchEntry
+}
+
+// NewOverlayStorage return an OverlayStorage over base.
+func NewOverlayStorage(base Storage) *OverlayStorage {
+	return &OverlayStorage{
+		base:    base,
+		entries: make(map[string]*batchEntry),
+	}
+}
+
+// Get return value to the key in Storage
+func (db *OverlayStorage) Get(key []byte) ([]byte, error) {
+	db.mu.RLock()
+	entry, ok := db.entries[byteutils.Hex(key)]
+	base := db.base
+	db.mu.RUnlock()
+	if !ok {
+		return base.Get(key)
+	}
+	if entry.del {
+		return nil, ErrKeyNotFound
+	}
+	return entry.value, nil
+}
+
+// Put put the key-value entry to Storage
+func (db *OverlayStorage) Put(key []byte, value []byte) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.entries[byteutils.Hex(key)] = &batchEntry{key: key, value: value}
+	return nil
+}
+
+// Del delete the key in Storage.
+func (db *OverlayStorage) Del(key []byte) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.entries[byteutils.Hex(key)] = &batchEntry{key: key, del: true}
+	return nil
+}
+
+// NewBatch return a Batch writing to Storage
+func (db *OverlayStorage) NewBatch() Batch {
+	return &memoryBatch{db: db}
+}
+
+// NewIterator return an Iterator over the entries whose key has the prefix
+func (db *OverlayStorage) NewIterator(prefix []byte) Iterator {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	iter := &memoryIterator{index: -1}
+	base := db.base.NewIterator(prefix)
+	for base.Next() {
+		if _, ok := db.entries[byteutils.Hex(base.Key())]; ok {
+			continue
+		}
+		iter.keys = append(iter.keys, append([]byte{}, base.Key()...))
+		iter.values = append(iter.values, append([]byte{}, base.Value()...))
+	}
+	iter.err = base.Error()
+	base.Release()
+
+	for _, entry := range db.entries {
+		if !entry.del && bytes.HasPrefix(entry.key, prefix) {
+			iter.keys = append(iter.keys, entry.key)
+			iter.values = append(iter.values, entry.value)
+		}
+	}
+	sort.Sort(iter)
+	return iter
+}
+
+// Flush write the buffered entries into batch, which is a batch of the base.
+func (db *OverlayStorage) Flush(batch Batch) error {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	for _, entry := range db.entries {
+		var err error
+		if entry.del {
+			err = batch.Del(entry.key)
+		} else {
+			err = batch.Put(entry.key, entry.value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rebase drop the buffered entries and read through base, called once the
+// flushed entries are written to base.
+func (db *OverlayStorage) Rebase(base Storage) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.base = base
+	db.entries = make(map[string]*batchEntry)
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlayStorage(t *testing.T) {
+	base, _ := NewMemoryStorage()
+	base.Put([]byte("key_1"), []byte("1"))
+	base.Put([]byte("key_2"), []byte("2"))
+
+	overlay := NewOverlayStorage(base)
+	assert.Nil(t, overlay.Put([]byte("key_3"), []byte("3")))
+	assert.Nil(t, overlay.Del([]byte("key_2")))
+	batch := overlay.NewBatch()
+	assert.Nil(t, batch.Put([]byte("key_1"), []byte("one")))
+	assert.Nil(t, batch.Write())
+
+	// reads see the overlay, the base is untouched.
+	value, err := overlay.Get([]byte("key_1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("one"), value)
+	_, err = overlay.Get([]byte("key_2"))
+	assert.Equal(t, ErrKeyNotFound, err)
+	value, err = base.Get([]byte("key_1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("1"), value)
+	_, err = base.Get([]byte("key_3"))
+	assert.Equal(t, ErrKeyNotFound, err)
+
+	iter := overlay.NewIterator([]byte("key_"))
+	var keys, values []string
+	for iter.Next() {
+		keys = append(keys, string(iter.Key()))
+		values = append(values, string(iter.Value()))
+	}
+	assert.Nil(t, iter.Error())
+	iter.Release()
+	assert.Equal(t, []string{"key_1", "key_3"}, keys)
+	assert.Equal(t, []string{"one", "3"}, values)
+
+	// the flushed entries are written to base with the batch.
+	batch = base.NewBatch()
+	assert.Nil(t, overlay.Flush(batch))
+	assert.Nil(t, batch.Write())
+	overlay.Rebase(base)
+	for _, s := range []Storage{base, overlay} {
+		value, err = s.Get([]byte("key_1"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("one"), value)
+		_, err = s.Get([]byte("key_2"))
+		assert.Equal(t, ErrKeyNotFound, err)
+		value, err = s.Get([]byte("key_3"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("3"), value)
+	}
+}
@@ -35,4 +35,40 @@ type Storage interface {
 
 	// Del delete the key entry in Storage.
 	Del(key []byte) error
+
+	// NewBatch return a Batch writing to Storage.
+	NewBatch() Batch
+
+	// NewIterator return an Iterator over the entries whose key has the prefix, in key order.
+	NewIterator(prefix []byte) Iterator
+}
+
+// Batch collects writes and commits them to Storage atomically.
+type Batch interface {
+	// Put put the key-value entry to Batch.
+	Put(key []byte, value []byte) error
+
+	// Del delete the key entry in Batch.
+	Del(key []byte) error
+
+	// Write commit all entries in Batch to Storage.
+	Write() error
+}
+
+// Iterator iterates the key-value entries in Storage.
+type Iterator interface {
+	// Next move to the next entry, return false if there is no more entry.
+	Next() bool
+
+	// Key return the key of current entry, it may be changed by the next call of Next.
+	Key() []byte
+
+	// Value return the value of current entry, it may be changed by the next call of Next.
+	Value() []byte
+
+	// Error return the error occurred in iteration.
+	Error() error
+
+	// Release release the resources of Iterator.
+	Release()
 }
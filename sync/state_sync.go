@@ -0,0 +1,505 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"errors"
+	gosync "sync"
+	"time"
+
+	pb "github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	log "github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	// PivotDistance the pivot block is the canonical block PivotDistance blocks below the tail of peers.
+	PivotDistance = 64
+
+	// MaxTrieNodesPerRequest max number of trie nodes requested in a message.
+	MaxTrieNodesPerRequest = 256
+
+	// MaxRequestsPerPeer max number of in-flight trie nodes requests to a peer.
+	MaxRequestsPerPeer = 4
+
+	pivotRequestTimeout     = 10 * time.Second
+	pivotRequestRetries     = 10
+	trieNodesRequestTimeout = 15 * time.Second
+)
+
+// errors
+var (
+	ErrNoPivotBlock = errors.New("cannot agree on a pivot block with peers")
+)
+
+type stateSyncPhase int
+
+const (
+	stateSyncIdle stateSyncPhase = iota
+	stateSyncPivot
+	stateSyncDownload
+)
+
+type trieNodesRequest struct {
+	peer   string
+	sentAt time.Time
+	// nodes requested and their kinds.
+	nodes map[byteutils.HexHash]nodeKind
+}
+
+// netService is the network StateSync works on, implemented by p2p.NetService.
+type netService interface {
+	Node() *p2p.Node
+	SyncPeers() []string
+	SendMsg(msgName string, msg []byte, target string) error
+	Register(subscribers ...*net.Subscriber)
+}
+
+// nodeKind the kind of the nodes downloaded, all of them are stored by their hashes.
+type nodeKind int
+
+const (
+	nodeTrie nodeKind = iota
+	// nodeState the node in a state trie, whose leaves are accounts.
+	nodeState
+	// nodeCode the code of a contract.
+	nodeCode
+)
+
+type trieNode struct {
+	hash byteutils.Hash
+	kind nodeKind
+}
+
+// StateSync downloads the states of a recent pivot block from peers,
+// and serves the states to other syncing nodes.
+// A node is accepted only if it is requested by hash, and its children are requested then,
+// so all the nodes and contract codes reachable from the roots in the pivot block header are verified.
+type StateSync struct {
+	blockChain *core.BlockChain
+	ns         netService
+
+	receivePivotCh      chan net.Message
+	receivePivotReplyCh chan net.Message
+	receiveNodesCh      chan net.Message
+	receiveNodesReplyCh chan net.Message
+	startCh             chan bool
+	doneCh              chan error
+	quitCh              chan bool
+	startOnce           gosync.Once
+
+	phase        stateSyncPhase
+	batch        uint64
+	pivotSentAt  time.Time
+	pivotRetries int
+	pivotQuorum  int
+	pivotVotes   map[byteutils.HexHash]int
+	pivot        *core.Block
+
+	queue     []*trieNode
+	scheduled map[byteutils.HexHash]bool
+	requests  map[uint64]*trieNodesRequest
+	synced    uint64
+}
+
+// NewStateSync create a new StateSync.
+func NewStateSync(blockChain *core.BlockChain, ns *p2p.NetService) *StateSync {
+	return newStateSync(blockChain, ns)
+}
+
+func newStateSync(blockChain *core.BlockChain, ns netService) *StateSync {
+	s := &StateSync{
+		blockChain:          blockChain,
+		ns:                  ns,
+		receivePivotCh:      make(chan net.Message, 128),
+		receivePivotReplyCh: make(chan net.Message, 128),
+		receiveNodesCh:      make(chan net.Message, 128),
+		receiveNodesReplyCh: make(chan net.Message, 128),
+		startCh:             make(chan bool, 1),
+		doneCh:              make(chan error, 1),
+		quitCh:              make(chan bool, 1),
+	}
+	ns.Register(net.NewSubscriber(s, s.receivePivotCh, net.MessageTypeSyncPivot))
+	ns.Register(net.NewSubscriber(s, s.receivePivotReplyCh, net.MessageTypeSyncPivotReply))
+	ns.Register(net.NewSubscriber(s, s.receiveNodesCh, net.MessageTypeSyncNodes))
+	ns.Register(net.NewSubscriber(s, s.receiveNodesReplyCh, net.MessageTypeSyncNodesReply))
+	return s
+}
+
+// Start start the loop serving and downloading states.
+func (s *StateSync) Start() {
+	s.startOnce.Do(func() {
+		go s.loop()
+	})
+}
+
+// Stop stop the loop.
+func (s *StateSync) Stop() {
+	s.quitCh <- true
+}
+
+// Run download the states of a pivot block agreed by peers, and set the pivot block as the tail.
+// It blocks until the states are synced or failed.
+func (s *StateSync) Run() error {
+	s.startCh <- true
+	return <-s.doneCh
+}
+
+func (s *StateSync) loop() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.quitCh:
+			return
+		case <-s.startCh:
+			s.pivotRetries = 0
+			s.requestPivot()
+		case <-ticker.C:
+			s.tick()
+		case msg := <-s.receivePivotCh:
+			s.handlePivotRequest(msg)
+		case msg := <-s.receivePivotReplyCh:
+			s.handlePivotReply(msg)
+		case msg := <-s.receiveNodesCh:
+			s.handleNodesRequest(msg)
+		case msg := <-s.receiveNodesReplyCh:
+			s.handleNodesReply(msg)
+		}
+	}
+}
+
+func (s *StateSync) tick() {
+	switch s.phase {
+	case stateSyncPivot:
+		if time.Since(s.pivotSentAt) < pivotRequestTimeout {
+			return
+		}
+		if s.pivotRetries >= pivotRequestRetries {
+			s.finish(ErrNoPivotBlock)
+			return
+		}
+		s.requestPivot()
+	case stateSyncDownload:
+		for batch, req := range s.requests {
+			if time.Since(req.sentAt) > trieNodesRequestTimeout {
+				log.WithFields(log.Fields{
+					"func":  "StateSync.tick",
+					"peer":  req.peer,
+					"nodes": len(req.nodes),
+				}).Warn("trie nodes request timeout.")
+				s.requeue(req)
+				delete(s.requests, batch)
+			}
+		}
+		s.schedule()
+	}
+}
+
+func (s *StateSync) finish(err error) {
+	if err != nil {
+		log.WithFields(log.Fields{
+			"func": "StateSync.finish",
+			"err":  err,
+		}).Error("state sync failed.")
+	} else {
+		log.WithFields(log.Fields{
+			"func":   "StateSync.finish",
+			"pivot":  s.pivot,
+			"synced": s.synced,
+		}).Info("state sync finished.")
+	}
+	s.phase = stateSyncIdle
+	s.pivot = nil
+	s.pivotVotes = nil
+	s.queue = nil
+	s.scheduled = nil
+	s.requests = nil
+	s.doneCh <- err
+}
+
+func (s *StateSync) requestPivot() {
+	s.phase = stateSyncPivot
+	s.batch++
+	s.pivotRetries++
+	s.pivotSentAt = time.Now()
+	s.pivotVotes = make(map[byteutils.HexHash]int)
+
+	peers := s.ns.SyncPeers()
+	s.pivotQuorum = len(peers)/2 + 1
+	data, err := pb.Marshal(&corepb.PivotRequest{From: s.ns.Node().ID(), Batch: s.batch})
+	if err != nil {
+		s.finish(err)
+		return
+	}
+	for _, peer := range peers {
+		go s.ns.SendMsg(net.MessageTypeSyncPivot, data, peer)
+	}
+	log.WithFields(log.Fields{
+		"func":  "StateSync.requestPivot",
+		"batch": s.batch,
+		"peers": len(peers),
+	}).Info("request pivot block.")
+}
+
+func (s *StateSync) handlePivotRequest(msg net.Message) {
+	if !s.ns.Node().GetSynchronized() {
+		return
+	}
+	req := new(corepb.PivotRequest)
+	if err := pb.Unmarshal(msg.Data().([]byte), req); err != nil {
+		log.Error("StateSync.handlePivotRequest: unmarshal data occurs error, ", err)
+		return
+	}
+	tail := s.blockChain.TailBlock()
+	if tail.Height() <= PivotDistance {
+		return
+	}
+	pivot := s.blockChain.GetBlockByHeight(tail.Height() - PivotDistance)
+	if pivot == nil {
+		return
+	}
+	reply, err := NewNetBlock(s.ns.Node().ID(), req.Batch, pivot).ToProto()
+	if err != nil {
+		return
+	}
+	data, err := pb.Marshal(reply)
+	if err != nil {
+		return
+	}
+	go s.ns.SendMsg(net.MessageTypeSyncPivotReply, data, msg.MessageFrom())
+}
+
+func (s *StateSync) handlePivotReply(msg net.Message) {
+	if s.phase != stateSyncPivot {
+		return
+	}
+	pbblock := new(corepb.NetBlock)
+	if err := pb.Unmarshal(msg.Data().([]byte), pbblock); err != nil {
+		log.Error("StateSync.handlePivotReply: unmarshal data occurs error, ", err)
+		return
+	}
+	reply := new(NetBlock)
+	if err := reply.FromProto(pbblock); err != nil {
+		log.Error("StateSync.handlePivotReply: get block from proto occurs error, ", err)
+		return
+	}
+	if reply.batch != s.batch {
+		return
+	}
+	// the pivot is only agreed by peers, it is not irreversible, and the blocks
+	// on top of it are verified by consensus as usual.
+	key := reply.block.Hash().Hex()
+	s.pivotVotes[key]++
+	if s.pivotVotes[key] < s.pivotQuorum {
+		return
+	}
+
+	s.phase = stateSyncDownload
+	s.pivot = reply.block
+	s.synced = 0
+	s.queue = nil
+	s.scheduled = make(map[byteutils.HexHash]bool)
+	s.requests = make(map[uint64]*trieNodesRequest)
+	dposContext := s.pivot.DposContext()
+	s.push(s.pivot.StateRoot(), nodeState)
+	for _, root := range []byteutils.Hash{
+		s.pivot.TxsRoot(),
+		s.pivot.EventsRoot(),
+		dposContext.DynastyRoot,
+		dposContext.NextDynastyRoot,
+		dposContext.DelegateRoot,
+		dposContext.CandidateRoot,
+		dposContext.VoteRoot,
+		dposContext.MintCntRoot,
+	} {
+		s.push(root, nodeTrie)
+	}
+	log.WithFields(log.Fields{
+		"func":  "StateSync.handlePivotReply",
+		"pivot": s.pivot,
+		"votes": s.pivotVotes[key],
+	}).Info("pivot block selected, start to download states.")
+	s.schedule()
+}
+
+func (s *StateSync) handleNodesRequest(msg net.Message) {
+	if !s.ns.Node().GetSynchronized() {
+		return
+	}
+	req := new(corepb.TrieNodesRequest)
+	if err := pb.Unmarshal(msg.Data().([]byte), req); err != nil {
+		log.Error("StateSync.handleNodesRequest: unmarshal data occurs error, ", err)
+		return
+	}
+	reply := &corepb.TrieNodes{From: s.ns.Node().ID(), Batch: req.Batch}
+	for i, h := range req.Hashes {
+		if i >= MaxTrieNodesPerRequest {
+			break
+		}
+		// only the trie nodes and contract codes, which are stored by their hashes, are served.
+		if value, err := s.blockChain.Storage().Get(h); err == nil && byteutils.Hash(h).Equals(hash.Sha3256(value)) {
+			reply.Nodes = append(reply.Nodes, value)
+		}
+	}
+	data, err := pb.Marshal(reply)
+	if err != nil {
+		return
+	}
+	go s.ns.SendMsg(net.MessageTypeSyncNodesReply, data, msg.MessageFrom())
+}
+
+func (s *StateSync) handleNodesReply(msg net.Message) {
+	if s.phase != stateSyncDownload {
+		return
+	}
+	reply := new(corepb.TrieNodes)
+	if err := pb.Unmarshal(msg.Data().([]byte), reply); err != nil {
+		log.Error("StateSync.handleNodesReply: unmarshal data occurs error, ", err)
+		return
+	}
+	req, ok := s.requests[reply.Batch]
+	if !ok || req.peer != msg.MessageFrom() {
+		return
+	}
+	delete(s.requests, reply.Batch)
+
+	batch := s.blockChain.Storage().NewBatch()
+	for _, value := range reply.Nodes {
+		key := byteutils.Hash(hash.Sha3256(value))
+		kind, ok := req.nodes[key.Hex()]
+		if !ok {
+			// not requested or not matching the hash.
+			continue
+		}
+		if err := s.expand(value, kind); err != nil {
+			log.WithFields(log.Fields{
+				"func": "StateSync.handleNodesReply",
+				"peer": req.peer,
+				"err":  err,
+			}).Warn("invalid trie node.")
+			continue
+		}
+		if err := batch.Put(key, value); err != nil {
+			s.finish(err)
+			return
+		}
+		delete(req.nodes, key.Hex())
+		s.synced++
+	}
+	if err := batch.Write(); err != nil {
+		s.finish(err)
+		return
+	}
+	// nodes missing in reply are requested again.
+	s.requeue(req)
+	s.schedule()
+}
+
+// push add the trie node to download queue if it is not scheduled.
+func (s *StateSync) push(h byteutils.Hash, kind nodeKind) {
+	if len(h) == 0 || s.scheduled[h.Hex()] {
+		return
+	}
+	s.scheduled[h.Hex()] = true
+	s.queue = append(s.queue, &trieNode{hash: h, kind: kind})
+}
+
+func (s *StateSync) requeue(req *trieNodesRequest) {
+	for key, kind := range req.nodes {
+		h, err := key.Hash()
+		if err != nil {
+			continue
+		}
+		s.queue = append(s.queue, &trieNode{hash: h, kind: kind})
+	}
+}
+
+// expand push the children of the node to download queue,
+// and the variables trie and code of the account if the node is a leaf in state trie.
+func (s *StateSync) expand(value []byte, kind nodeKind) error {
+	if kind == nodeCode {
+		return nil
+	}
+	children, leafVal, err := trie.NodeChildren(value)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		s.push(child, kind)
+	}
+	if kind == nodeState && leafVal != nil {
+		pbAcc := new(corepb.Account)
+		if err := pb.Unmarshal(leafVal, pbAcc); err != nil {
+			return err
+		}
+		s.push(pbAcc.VarsHash, nodeTrie)
+		s.push(pbAcc.CodeHash, nodeCode)
+	}
+	return nil
+}
+
+// schedule send the queued nodes to peers, nodes already in storage are expanded locally.
+func (s *StateSync) schedule() {
+	inflight := make(map[string]int)
+	for _, req := range s.requests {
+		inflight[req.peer]++
+	}
+	for _, peer := range s.ns.SyncPeers() {
+		for inflight[peer] < MaxRequestsPerPeer && len(s.queue) > 0 {
+			req := &trieNodesRequest{peer: peer, sentAt: time.Now(), nodes: make(map[byteutils.HexHash]nodeKind)}
+			var hashes [][]byte
+			for len(hashes) < MaxTrieNodesPerRequest && len(s.queue) > 0 {
+				n := s.queue[len(s.queue)-1]
+				s.queue = s.queue[:len(s.queue)-1]
+				if value, err := s.blockChain.Storage().Get(n.hash); err == nil && n.hash.Equals(hash.Sha3256(value)) {
+					if err := s.expand(value, n.kind); err == nil {
+						continue
+					}
+				}
+				req.nodes[n.hash.Hex()] = n.kind
+				hashes = append(hashes, n.hash)
+			}
+			if len(hashes) == 0 {
+				break
+			}
+			s.batch++
+			data, err := pb.Marshal(&corepb.TrieNodesRequest{From: s.ns.Node().ID(), Batch: s.batch, Hashes: hashes})
+			if err != nil {
+				s.requeue(req)
+				return
+			}
+			s.requests[s.batch] = req
+			inflight[peer]++
+			go s.ns.SendMsg(net.MessageTypeSyncNodes, data, peer)
+		}
+	}
+
+	if len(s.queue) == 0 && len(s.requests) == 0 {
+		_, err := s.blockChain.SetSnapshotTail(s.pivot)
+		s.finish(err)
+	}
+}
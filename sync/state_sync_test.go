@@ -0,0 +1,305 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/net/messages"
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockDynasty = []string{
+		"1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
+		"2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8",
+		"333cb3ed8c417971845382ede3cf67a0a96270c05fe2f700",
+		"48f981ed38910f1232c1bab124f650c482a57271632db9e3",
+		"59fc526072b09af8a8ca9732dae17132c4e9127e43cf2232",
+		"75e4e5a71d647298b88928d8cb5da43d90ab1a6c52d0905f",
+	}
+
+	errSyncTimeout = errors.New("state sync timeout")
+)
+
+type mockNeb struct {
+	genesis *corepb.Genesis
+	storage storage.Storage
+	emitter *core.EventEmitter
+}
+
+func (n *mockNeb) Genesis() *corepb.Genesis {
+	return n.genesis
+}
+
+func (n *mockNeb) Storage() storage.Storage {
+	return n.storage
+}
+
+func (n *mockNeb) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+func (n *mockNeb) StartSync() {}
+
+type mockConsensus struct{}
+
+func (c mockConsensus) FastVerifyBlock(block *core.Block) error {
+	block.SetMiner(block.Coinbase())
+	return nil
+}
+
+func (c mockConsensus) VerifyBlock(block *core.Block, parent *core.Block) error {
+	block.SetMiner(block.Coinbase())
+	return nil
+}
+
+type sentMsg struct {
+	name   string
+	data   []byte
+	target string
+}
+
+type mockNetService struct {
+	node  *p2p.Node
+	peers []string
+	sent  chan *sentMsg
+}
+
+func newMockNetService(peers ...string) *mockNetService {
+	node := new(p2p.Node)
+	node.SetSynchronized(true)
+	return &mockNetService{node: node, peers: peers, sent: make(chan *sentMsg, 1024)}
+}
+
+func (n *mockNetService) Node() *p2p.Node {
+	return n.node
+}
+
+func (n *mockNetService) SyncPeers() []string {
+	return n.peers
+}
+
+func (n *mockNetService) SendMsg(msgName string, msg []byte, target string) error {
+	n.sent <- &sentMsg{name: msgName, data: msg, target: target}
+	return nil
+}
+
+func (n *mockNetService) Register(subscribers ...*net.Subscriber) {}
+
+func (n *mockNetService) receive(t *testing.T) *sentMsg {
+	select {
+	case msg := <-n.sent:
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("no message sent.")
+		return nil
+	}
+}
+
+// testBlockChain return a chain of the given height, with empty blocks on top of the genesis.
+func testBlockChain(t *testing.T, height int) *core.BlockChain {
+	stor, _ := storage.NewMemoryStorage()
+	neb := &mockNeb{
+		genesis: &corepb.Genesis{
+			Meta:      &corepb.GenesisMeta{ChainId: 0},
+			Consensus: &corepb.GenesisConsensus{Dpos: &corepb.GenesisConsensusDpos{Dynasty: mockDynasty}},
+			TokenDistribution: []*corepb.GenesisTokenDistribution{
+				&corepb.GenesisTokenDistribution{Address: mockDynasty[0], Value: "10000000000000000000000"},
+			},
+		},
+		storage: stor,
+		emitter: core.NewEventEmitter(),
+	}
+	bc, err := core.NewBlockChain(neb)
+	assert.Nil(t, err)
+	bc.SetConsensusHandler(mockConsensus{})
+	coinbase, _ := core.AddressParse(mockDynasty[0])
+	for i := 1; i < height; i++ {
+		block, err := bc.NewBlock(coinbase)
+		assert.Nil(t, err)
+		block.SetTimestamp(core.BlockInterval * int64(i))
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, bc.BlockPool().Push(block))
+		assert.Nil(t, bc.SetTailBlock(block))
+	}
+	return bc
+}
+
+// agreePivot let the client agree on the pivot served by server with two of its three peers.
+func agreePivot(t *testing.T, client *StateSync, clientNet *mockNetService, server *StateSync, serverNet *mockNetService) {
+	client.requestPivot()
+	assert.Equal(t, client.phase, stateSyncPivot)
+	assert.Equal(t, client.pivotQuorum, 2)
+
+	var requests []*sentMsg
+	for range clientNet.peers {
+		msg := clientNet.receive(t)
+		assert.Equal(t, msg.name, net.MessageTypeSyncPivot)
+		requests = append(requests, msg)
+	}
+
+	// a vote on another block does not count for the pivot.
+	other, err := NewNetBlock("", client.batch, server.blockChain.TailBlock()).ToProto()
+	assert.Nil(t, err)
+	data, err := pb.Marshal(other)
+	assert.Nil(t, err)
+	client.handlePivotReply(messages.NewBaseMessage(net.MessageTypeSyncPivotReply, requests[0].target, data))
+
+	for i, req := range requests[1:] {
+		server.handlePivotRequest(messages.NewBaseMessage(req.name, req.target, req.data))
+		reply := serverNet.receive(t)
+		assert.Equal(t, reply.name, net.MessageTypeSyncPivotReply)
+		client.handlePivotReply(messages.NewBaseMessage(reply.name, req.target, reply.data))
+		if i == 0 {
+			assert.Equal(t, client.phase, stateSyncPivot)
+		}
+	}
+	assert.Equal(t, client.phase, stateSyncDownload)
+}
+
+// serveNodes relay the trie nodes requests of client to server until the state sync finishes.
+func serveNodes(t *testing.T, client *StateSync, clientNet *mockNetService, server *StateSync, serverNet *mockNetService) error {
+	for {
+		select {
+		case err := <-client.doneCh:
+			return err
+		case req := <-clientNet.sent:
+			assert.Equal(t, req.name, net.MessageTypeSyncNodes)
+			server.handleNodesRequest(messages.NewBaseMessage(req.name, req.target, req.data))
+			reply := serverNet.receive(t)
+			assert.Equal(t, reply.name, net.MessageTypeSyncNodesReply)
+			client.handleNodesReply(messages.NewBaseMessage(reply.name, req.target, reply.data))
+		case <-time.After(time.Second):
+			return errSyncTimeout
+		}
+	}
+}
+
+func TestStateSync(t *testing.T) {
+	serverChain := testBlockChain(t, PivotDistance+3)
+	serverNet := newMockNetService()
+	server := newStateSync(serverChain, serverNet)
+	clientChain := testBlockChain(t, 1)
+	clientNet := newMockNetService("peer0", "peer1", "peer2")
+	client := newStateSync(clientChain, clientNet)
+
+	agreePivot(t, client, clientNet, server, serverNet)
+	pivot := serverChain.GetBlockByHeight(serverChain.TailBlock().Height() - PivotDistance)
+	assert.Equal(t, client.pivot.Hash(), pivot.Hash())
+
+	// contract codes are downloaded like trie nodes, without children.
+	code := (&core.ContractCode{SourceType: "js", Source: "source"}).ToBytes()
+	codeHash := byteutils.Hash(hash.Sha3256(code))
+	assert.Nil(t, serverChain.Storage().Put(codeHash, code))
+	client.push(codeHash, nodeCode)
+
+	assert.Nil(t, serveNodes(t, client, clientNet, server, serverNet))
+	stored, err := clientChain.Storage().Get(codeHash)
+	assert.Nil(t, err)
+	assert.Equal(t, code, stored)
+	assert.True(t, client.synced > 0)
+	assert.Equal(t, client.phase, stateSyncIdle)
+	assert.Equal(t, clientChain.TailBlock().Hash(), pivot.Hash())
+	assert.True(t, core.CheckGenesisBlock(clientChain.LIB()))
+	coinbase, _ := core.AddressParse(mockDynasty[0])
+	assert.Equal(t, clientChain.TailBlock().GetBalance(coinbase.Bytes()), pivot.GetBalance(coinbase.Bytes()))
+}
+
+func TestStateSync_NodesRequest(t *testing.T) {
+	serverChain := testBlockChain(t, 2)
+	serverNet := newMockNetService()
+	server := newStateSync(serverChain, serverNet)
+	tail := serverChain.TailBlock()
+
+	// only the state root is served, the block is stored by its hash but it is not a trie node.
+	missing := byteutils.Hash(hash.Sha3256([]byte("missing")))
+	data, err := pb.Marshal(&corepb.TrieNodesRequest{Batch: 1, Hashes: [][]byte{tail.StateRoot(), tail.Hash(), missing}})
+	assert.Nil(t, err)
+	server.handleNodesRequest(messages.NewBaseMessage(net.MessageTypeSyncNodes, "peer0", data))
+	msg := serverNet.receive(t)
+	assert.Equal(t, msg.target, "peer0")
+	reply := new(corepb.TrieNodes)
+	assert.Nil(t, pb.Unmarshal(msg.data, reply))
+	assert.Equal(t, reply.Batch, uint64(1))
+	assert.Equal(t, len(reply.Nodes), 1)
+	assert.Equal(t, byteutils.Hash(hash.Sha3256(reply.Nodes[0])), tail.StateRoot())
+
+	// an unsynchronized node serves nothing.
+	serverNet.node.SetSynchronized(false)
+	server.handleNodesRequest(messages.NewBaseMessage(net.MessageTypeSyncNodes, "peer0", data))
+	select {
+	case <-serverNet.sent:
+		t.Error("unexpected reply.")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestStateSync_BadNodes(t *testing.T) {
+	serverChain := testBlockChain(t, PivotDistance+3)
+	serverNet := newMockNetService()
+	server := newStateSync(serverChain, serverNet)
+	clientChain := testBlockChain(t, 1)
+	clientNet := newMockNetService("peer0", "peer1", "peer2")
+	client := newStateSync(clientChain, clientNet)
+	agreePivot(t, client, clientNet, server, serverNet)
+
+	msg := clientNet.receive(t)
+	req := new(corepb.TrieNodesRequest)
+	assert.Nil(t, pb.Unmarshal(msg.data, req))
+	forged := []byte("forged")
+	data, err := pb.Marshal(&corepb.TrieNodes{Batch: req.Batch, Nodes: [][]byte{forged}})
+	assert.Nil(t, err)
+
+	// the reply from a peer not requested is dropped.
+	other := "peer0"
+	if msg.target == other {
+		other = "peer1"
+	}
+	client.handleNodesReply(messages.NewBaseMessage(net.MessageTypeSyncNodesReply, other, data))
+	assert.NotNil(t, client.requests[req.Batch])
+
+	// the nodes not matching the requested hashes are rejected, and the hashes are requested again.
+	client.handleNodesReply(messages.NewBaseMessage(net.MessageTypeSyncNodesReply, msg.target, data))
+	assert.Nil(t, client.requests[req.Batch])
+	assert.Equal(t, client.synced, uint64(0))
+	_, err = clientChain.Storage().Get(hash.Sha3256(forged))
+	assert.Equal(t, err, storage.ErrKeyNotFound)
+	for _, h := range req.Hashes {
+		_, err := clientChain.Storage().Get(h)
+		assert.Equal(t, err, storage.ErrKeyNotFound)
+	}
+
+	// the sync completes with honest replies.
+	assert.Nil(t, serveNodes(t, client, clientNet, server, serverNet))
+	pivot := serverChain.GetBlockByHeight(serverChain.TailBlock().Height() - PivotDistance)
+	assert.Equal(t, clientChain.TailBlock().Hash(), pivot.Hash())
+}
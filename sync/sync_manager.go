@@ -19,6 +19,7 @@
 package sync
 
 import (
+	gosync "sync"
 	"time"
 
 	pb "github.com/gogo/protobuf/proto"
@@ -54,6 +55,10 @@ type Manager struct {
 	curTail                *core.Block
 	canSyncWithBlockListCh chan bool
 	goParentSyncCh         chan bool
+	stateSync              *StateSync
+	fastSync               bool
+	stateSyncing           bool
+	stateSyncingLock       gosync.Mutex
 }
 
 // NewManager new sync manager
@@ -71,6 +76,10 @@ func NewManager(blockChain *core.BlockChain, consensus consensus.Consensus, ns *
 		blockChain.TailBlock(),
 		make(chan bool, 1),
 		make(chan bool, 1),
+		NewStateSync(blockChain, ns),
+		false,
+		false,
+		gosync.Mutex{},
 	}
 	m.RegisterSyncBlockInNetwork(ns)
 	m.RegisterSyncReplyInNetwork(ns)
@@ -97,8 +106,17 @@ func (m *Manager) RegisterSyncReplyInNetwork(nm net.Manager) {
 6. if all remote peers return the number of blocks less than 10, end sync
 */
 func (m *Manager) Start() {
+	m.stateSync.Start()
+	m.stateSyncingLock.Lock()
+	defer m.stateSyncingLock.Unlock()
 	// if the node is syncing, return.
-	if m.ns.Node().GetSynchronized() {
+	if m.ns.Node().GetSynchronized() || m.stateSyncing {
+		return
+	}
+	// the sync messages are handled after the states are synced, when curTail is set.
+	if len(m.ns.Node().Config().BootNodes) > 0 && m.fastSync && core.CheckGenesisBlock(m.blockChain.TailBlock()) {
+		m.stateSyncing = true
+		go m.startFastSync()
 		return
 	}
 	m.startMsgHandle()
@@ -113,6 +131,23 @@ func (m *Manager) Start() {
 	}
 }
 
+// EnableFastSync download the states of a recent pivot block before syncing blocks, when the chain is empty.
+func (m *Manager) EnableFastSync() {
+	m.fastSync = true
+}
+
+func (m *Manager) startFastSync() {
+	if err := m.stateSync.Run(); err != nil {
+		log.Warn("startFastSync: state sync failed, sync blocks from genesis, ", err)
+	}
+	m.stateSyncingLock.Lock()
+	defer m.stateSyncingLock.Unlock()
+	m.curTail = m.blockChain.TailBlock()
+	m.stateSyncing = false
+	m.startMsgHandle()
+	m.startSync()
+}
+
 func (m *Manager) startSync() {
 	go m.loop()
 	m.syncWithPeers(m.curTail)